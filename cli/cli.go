@@ -8,10 +8,10 @@ package cli
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
-	"bufio"
 	"bytes"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -30,8 +30,6 @@ import (
 	"github.com/essentialkaos/ek/v13/usage/completion/zsh"
 	"github.com/essentialkaos/ek/v13/usage/man"
 	"github.com/essentialkaos/ek/v13/usage/update"
-
-	"github.com/tidwall/gjson"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -55,6 +53,33 @@ const (
 	OPT_HELP     = "h:help"
 	OPT_VER      = "v:version"
 
+	OPT_CONFIG        = "c:config"
+	OPT_FORMAT        = "format"
+	OPT_MESSAGE_FIELD = "mf:message-field"
+	OPT_LEVEL_FIELD   = "lf:level-field"
+	OPT_CALLER_FIELD  = "cf:caller-field"
+	OPT_TIME_FIELD    = "tf:time-field"
+	OPT_FLATTEN       = "FL:flatten"
+	OPT_EXPAND        = "E:expand"
+	OPT_TIME_LAYOUT   = "tl:time-layout"
+	OPT_TIME_FORMAT   = "TF:time-format"
+	OPT_TIMEZONE      = "tz:timezone"
+	OPT_SINCE         = "s:since"
+	OPT_UNTIL         = "u:until"
+	OPT_IGNORE_CASE   = "i:ignore-case"
+	OPT_MIN_LEVEL     = "l:level"
+	OPT_AFTER         = "A:after"
+	OPT_BEFORE        = "B:before"
+	OPT_CONTEXT       = "C:context"
+	OPT_COUNT         = "count"
+	OPT_STATS         = "stats"
+	OPT_GROUP_BY      = "g:group-by"
+	OPT_AGG           = "a:agg"
+	OPT_DEDUP         = "D:dedup"
+	OPT_DEDUP_SIMILAR = "DS:dedup-similar"
+	OPT_PATTERNS      = "patterns"
+	OPT_LINES         = "n:lines"
+
 	OPT_UPDATE       = "U:update"
 	OPT_VERB_VER     = "vv:verbose-version"
 	OPT_COMPLETION   = "completion"
@@ -69,6 +94,8 @@ const (
 	TYPE_NUMBER
 	TYPE_BOOL
 	TYPE_NIL
+	TYPE_OBJECT
+	TYPE_ARRAY
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -92,6 +119,33 @@ var optMap = options.Map{
 	OPT_HELP:     {Type: options.BOOL},
 	OPT_VER:      {Type: options.MIXED},
 
+	OPT_CONFIG:        {},
+	OPT_FORMAT:        {},
+	OPT_MESSAGE_FIELD: {},
+	OPT_LEVEL_FIELD:   {},
+	OPT_CALLER_FIELD:  {},
+	OPT_TIME_FIELD:    {},
+	OPT_FLATTEN:       {Type: options.BOOL},
+	OPT_EXPAND:        {Type: options.BOOL},
+	OPT_TIME_LAYOUT:   {},
+	OPT_TIME_FORMAT:   {},
+	OPT_TIMEZONE:      {},
+	OPT_SINCE:         {},
+	OPT_UNTIL:         {},
+	OPT_IGNORE_CASE:   {Type: options.BOOL},
+	OPT_MIN_LEVEL:     {},
+	OPT_AFTER:         {Type: options.INT, Min: 0, Max: MAX_CONTEXT_SIZE},
+	OPT_BEFORE:        {Type: options.INT, Min: 0, Max: MAX_CONTEXT_SIZE},
+	OPT_CONTEXT:       {Type: options.INT, Min: 0, Max: MAX_CONTEXT_SIZE},
+	OPT_COUNT:         {Type: options.BOOL},
+	OPT_STATS:         {Type: options.BOOL},
+	OPT_GROUP_BY:      {},
+	OPT_AGG:           {Mergeble: true},
+	OPT_DEDUP:         {Type: options.BOOL},
+	OPT_DEDUP_SIMILAR: {Type: options.BOOL},
+	OPT_PATTERNS:      {Type: options.BOOL},
+	OPT_LINES:         {Type: options.INT, Min: 1, Max: MAX_TAIL_SIZE},
+
 	OPT_UPDATE:       {Type: options.MIXED},
 	OPT_VERB_VER:     {Type: options.BOOL},
 	OPT_COMPLETION:   {},
@@ -104,6 +158,7 @@ var colorTagApp, colorTagVer string
 // textColors is map with message text colors
 var textColors = map[string]string{
 	"":      "",
+	"trace": "{s-}",
 	"debug": "{s-}",
 	"info":  "",
 	"warn":  "{#220}",
@@ -114,6 +169,7 @@ var textColors = map[string]string{
 // textColors is a map with marker colors
 var markerColors = map[string]string{
 	"":      "{s-}",
+	"trace": "{s-}",
 	"debug": "{s-}",
 	"info":  "{s-}",
 	"warn":  "{#220}",
@@ -135,11 +191,16 @@ var typeColors = map[uint8]string{
 	TYPE_NUMBER:  "{*}{#109}",
 	TYPE_NIL:     "{*}{s}",
 	TYPE_BOOL:    "{#74}",
+	TYPE_OBJECT:  "",
+	TYPE_ARRAY:   "",
 }
 
 // strictMode strict mode flag
 var strictMode bool
 
+// expandMode is expanded objects rendering flag
+var expandMode bool
+
 // highlights is slice with texts to highlight
 var highlights Highlights
 
@@ -228,150 +289,292 @@ func configureUI() {
 
 // process starts arguments processing
 func process(args options.Arguments) error {
-	source, filters, err := getDataSource(args)
+	config, err := readConfig()
+
+	if err != nil {
+		return err
+	}
+
+	err = configureFields(config)
+
+	if err != nil {
+		return err
+	}
+
+	err = configureTime(config)
+
+	if err != nil {
+		return err
+	}
+
+	err = configureTimeRange()
+
+	if err != nil {
+		return err
+	}
+
+	err = configureLevel()
+
+	if err != nil {
+		return err
+	}
+
+	err = configureStats()
+
+	if err != nil {
+		return err
+	}
+
+	err = configureGrouping()
+
+	if err != nil {
+		return err
+	}
+
+	err = configurePatterns()
+
+	if err != nil {
+		return err
+	}
+
+	files, filters, err := getSourceFiles(args)
+
+	if err != nil {
+		return err
+	}
+
+	ignoreCase = options.GetB(OPT_IGNORE_CASE)
+	query, err := parseQuery(filters)
+
+	if err != nil {
+		return err
+	}
+
+	err = configureTail(files)
 
 	if err != nil {
 		return err
 	}
 
+	configureContext()
+	configureDedup()
+
 	strictMode = options.GetB(OPT_STRICT)
+	expandMode = options.GetB(OPT_EXPAND)
 
 	if options.Has(OPT_FIND) {
-		highlights = Highlights(strings.Split(options.GetS(OPT_FIND), "\n"))
+		highlights = parseHighlights(strings.Split(options.GetS(OPT_FIND), "\n"))
+	}
+
+	sources, err := openSources(files)
+
+	if err != nil {
+		return err
+	}
+
+	err = tailSources(sources)
+
+	if err != nil {
+		return err
 	}
 
 	if options.GetB(OPT_FOLLOW) {
-		readDataStream(source, parseFilters(filters))
+		readDataStream(sources, query)
 	} else {
-		readData(source, parseFilters(filters))
+		readData(sources, query)
 	}
 
 	return nil
 }
 
-// getSource returns data source
-func getDataSource(args options.Arguments) (*os.File, []string, error) {
-	if hasStdinData() {
-		return os.Stdin, args.Strings(), nil
+// readData reads all data from given sources and merges records from
+// different sources by timestamp
+func readData(sources []*Source, query *Query) {
+	if !options.GetB(OPT_NO_PAGER) {
+		if pager.Setup() == nil {
+			defer pager.Complete()
+		}
 	}
 
-	fd, err := os.OpenFile(args.Get(0).Clean().String(), os.O_RDONLY, 0)
+	if isFormatDetectionRequired() {
+		var sample []string
 
-	if err != nil {
-		return nil, nil, fmt.Errorf("Can't open file for reading: %w", err)
+		for _, s := range sources {
+			sample = append(sample, s.ReadSample(FORMAT_SAMPLE_SIZE)...)
+		}
+
+		applyFormat(detectFormat(sample))
 	}
 
-	return fd, args[1:].Strings(), nil
-}
+	var active []*Source
 
-// readData reads all data from given source
-func readData(source *os.File, filters Filters) {
-	r := bufio.NewReader(source)
-	s := bufio.NewScanner(r)
+	isMerge := len(sources) > 1
 
-	if !options.GetB(OPT_NO_PAGER) {
-		if pager.Setup() == nil {
-			defer pager.Complete()
+	// Data from pipes may be concatenated from several unsorted parts, so it
+	// is always read till the end
+	canStop := !slices.ContainsFunc(sources, func(s *Source) bool {
+		return !s.isRegularFile()
+	})
+
+	for _, s := range sources {
+		if s.Next() {
+			if isMerge {
+				s.UpdateTimestamp()
+			}
+
+			active = append(active, s)
 		}
 	}
 
-	for s.Scan() {
-		data := s.Text()
-		data = strings.TrimSpace(data)
+	for len(active) != 0 {
+		index := 0
+
+		if isMerge {
+			index = getEarliestSource(active)
+		}
+
+		s := active[index]
 
-		if data == "" {
+		renderLine(s.line, s, query)
+
+		if canStop && timeRange.IsOver() {
+			break
+		}
+
+		if !s.Next() {
+			active = slices.Delete(active, index, index+1)
 			continue
 		}
 
-		renderLine(data, filters)
+		if isMerge {
+			s.UpdateTimestamp()
+		}
 	}
 
-	source.Close()
+	dedup.Flush()
+	closeSources(sources)
+
+	if collector != nil {
+		collector.Print()
+	}
 }
 
-// readDataStream reads stream of data from given source
-func readDataStream(source *os.File, filters Filters) {
-	r := bufio.NewReader(source)
-	lastPrint := time.Now()
+// readDataStream reads stream of data from given sources
+func readDataStream(sources []*Source, query *Query) {
+	lines := make(chan sourceLine)
+	lastPrint, lastLine := time.Now(), time.Now()
+	needDetect := isFormatDetectionRequired()
+	activeSources := len(sources)
+
+	for _, s := range sources {
+		go s.Follow(lines)
+	}
 
 	for {
-		line, err := r.ReadString('\n')
+		var l sourceLine
+
+		select {
+		case l = <-lines:
+		case <-time.After(100 * time.Millisecond):
+			if time.Since(lastLine) > DEDUP_FLUSH_DELAY {
+				dedup.Reset()
+			}
 
-		if err != nil {
-			time.Sleep(50 * time.Millisecond)
 			continue
 		}
 
-		line = strings.TrimRight(line, "\r\n")
+		lastLine = time.Now()
+
+		if l.Notice != "" {
+			dedup.Reset()
+			fmtutil.Separator(true, l.Notice)
+			continue
+		}
+
+		if l.IsEnd {
+			activeSources--
+
+			if activeSources == 0 {
+				dedup.Flush()
+				return
+			}
+
+			continue
+		}
+
+		if needDetect && strings.HasPrefix(l.Line, "{") {
+			applyFormat(detectFormat([]string{l.Line}))
+			needDetect = false
+		}
 
 		if time.Since(lastPrint) > 30*time.Second {
+			dedup.Reset()
 			fmtutil.Separator(true, timeutil.Pretty(time.Since(lastPrint)).Short(true))
 		}
 
-		if renderLine(line, filters) {
+		if renderLine(l.Line, l.Source, query) {
 			lastPrint = time.Now()
 		}
 	}
 }
 
-// renderLine renders log line
-func renderLine(line string, filters Filters) bool {
-	var msg, level, caller string
-	var ts float64
-	var fields []Field
+// getEarliestSource returns index of source with the earliest current record
+func getEarliestSource(sources []*Source) int {
+	var result int
 
-	json := gjson.Parse(line)
+	for i, s := range sources {
+		if s.ts.Before(sources[result].ts) {
+			result = i
+		}
+	}
 
-	if !json.IsObject() {
-		if strictMode {
+	return result
+}
+
+// renderLine renders log line
+func renderLine(line string, src *Source, query *Query) bool {
+	rec := parseRecord(line)
+
+	if rec == nil {
+		if strictMode || collector != nil {
 			return false
 		}
 
+		dedup.Reset()
+
 		fmtc.If(!fmtc.DisableColors).Print("{#169}▎{!}")
+		src.PrintLabel()
 		fmtc.Printfn("{s-}%s{!}", line)
 
 		return true
 	}
 
-	json.ForEach(func(k, v gjson.Result) bool {
-		key := k.String()
-
-		switch key {
-		case "msg", "log":
-			msg = v.String()
-		case "level":
-			level = v.String()
-		case "caller":
-			caller = v.String()
-		case "ts":
-			ts = v.Float()
-		default:
-			switch v.Type {
-			case gjson.String:
-				fields = append(fields, Field{key, fmt.Sprintf("\"%s\"", v.Value()), TYPE_STRING})
-			case gjson.False, gjson.True:
-				fields = append(fields, Field{key, fmt.Sprintf("%t", v.Bool()), TYPE_BOOL})
-			case gjson.Null:
-				fields = append(fields, Field{key, "nil", TYPE_NIL})
-			case gjson.Number:
-				fields = append(fields, Field{key, v.String(), TYPE_NUMBER})
-			default:
-				fields = append(fields, Field{key, fmt.Sprintf("%v", v.Value()), TYPE_UNKNOWN})
-			}
-		}
+	if rec.Msg == "" || !timeRange.IsMatch(rec.TS) {
+		return false
+	}
 
-		return true
-	})
+	rec.Source = src
 
-	if msg == "" {
-		return false
+	isMatch := isLevelMatch(rec.Level) && query.IsMatch(rec)
+
+	if collector != nil {
+		if isMatch {
+			collector.Add(rec)
+		}
+
+		return isMatch
 	}
 
-	if len(filters) != 0 && !filters.IsMatch(json.Map()) {
-		return false
+	return matchContext.Add(rec, isMatch)
+}
+
+// printRecord prints log record
+func printRecord(rec *Record) {
+	if dedup.IsRepeat(rec) {
+		return
 	}
 
-	recDate := time.UnixMicro(int64(ts * 1_000_000))
+	msg, level, caller := rec.Msg, rec.Level, rec.Caller
 	markerColor := markerColors[level]
 
 	if len(highlights) > 0 {
@@ -386,11 +589,10 @@ func renderLine(line string, filters Filters) bool {
 
 	fmtc.If(!fmtc.DisableColors).Print(markerColor + "▎{!}")
 
-	fmtc.Printf(
-		"{s-}[ {s}%s{s-}.%s ]{!} ",
-		timeutil.Format(recDate, "%y/%m/%d %H:%M:%S"),
-		timeutil.Format(recDate, "%K"),
-	)
+	labelSize := rec.Source.PrintLabel()
+	ts, tsSize := formatTimestamp(rec.TS)
+
+	fmtc.Print("{s-}[ " + ts + "{s-} ]{!} ")
 
 	switch level {
 	case "warn", "error", "fatal":
@@ -404,30 +606,32 @@ func renderLine(line string, filters Filters) bool {
 
 	fmtc.Printf(textColors[level]+"%s{!}\n", msg)
 
-	if len(fields) != 0 {
-		prefixSize := 26
+	if len(rec.Fields) != 0 {
+		prefixSize := labelSize + tsSize + 5
 
 		if caller != "" {
 			prefixSize += len(caller) + 3
 		}
 
-		renderFields(level, prefixSize, fields)
+		renderFields(level, prefixSize, rec.Fields)
 	}
-
-	return true
 }
 
 // renderFields renders log fields
 func renderFields(level string, prefixSize int, fields []Field) {
 	var lineLen int
+	var expanded []Field
 
 	buf := &bytes.Buffer{}
 
 	for _, f := range fields {
+		if expandMode && f.IsContainer() && f.Size() > 88 {
+			expanded = append(expanded, f)
+			continue
+		}
+
 		if lineLen > 0 && lineLen+f.Size() > 88 {
-			fmtc.If(!fmtc.DisableColors).Print(markerColors[level] + "▎{!}")
-			fmt.Print(strings.Repeat(" ", prefixSize))
-			fmtc.Println(buf.String())
+			renderFieldsLine(level, prefixSize, buf.String())
 			buf.Reset()
 			lineLen = 0
 		}
@@ -437,21 +641,38 @@ func renderFields(level string, prefixSize int, fields []Field) {
 			lineLen += 3
 		}
 
-		fmt.Fprintf(
-			buf, "{#243}%s{!}{s-}:{!}"+typeColors[f.Type]+"%s{!}",
-			f.Name, f.Value,
-		)
+		if f.IsContainer() {
+			fmt.Fprintf(buf, "{#243}%s{!}{s-}:{!}%s", f.Name, colorizeJSON(f.Value))
+		} else {
+			fmt.Fprintf(
+				buf, "{#243}%s{!}{s-}:{!}"+typeColors[f.Type]+"%s{!}",
+				f.Name, f.Value,
+			)
+		}
 
 		lineLen += f.Size()
 	}
 
 	if buf.Len() != 0 {
-		fmtc.If(!fmtc.DisableColors).Print(markerColors[level] + "▎{!}")
-		fmt.Print(strings.Repeat(" ", prefixSize))
-		fmtc.Println(buf.String())
+		renderFieldsLine(level, prefixSize, buf.String())
+	}
+
+	for _, f := range expanded {
+		renderFieldsLine(level, prefixSize, "{#243}"+f.Name+"{!}{s-}:{!}")
+
+		for _, line := range colorizeJSONTree(f.Value) {
+			renderFieldsLine(level, prefixSize, JSON_INDENT+line)
+		}
 	}
 }
 
+// renderFieldsLine renders line with fields
+func renderFieldsLine(level string, prefixSize int, line string) {
+	fmtc.If(!fmtc.DisableColors).Print(markerColors[level] + "▎{!}")
+	fmt.Print(strings.Repeat(" ", prefixSize))
+	fmtc.Println(line)
+}
+
 // hasStdinData return true if there is some data in stdin
 func hasStdinData() bool {
 	stdin, err := os.Stdin.Stat()
@@ -469,6 +690,11 @@ func hasStdinData() bool {
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+// IsContainer returns true if field contains object or array
+func (f Field) IsContainer() bool {
+	return f.Type == TYPE_OBJECT || f.Type == TYPE_ARRAY
+}
+
 // Size returns visual size of the field
 func (f Field) Size() int {
 	if f.Type == TYPE_STRING {
@@ -505,24 +731,73 @@ func printMan() {
 
 // genUsage generates usage info
 func genUsage() *usage.Info {
-	info := usage.NewInfo("", "?source|filter", "?filter…")
+	info := usage.NewInfo("", "?source…|query", "?query…")
 
 	info.AddSpoiler(`You can filter log records using a simple query language.
 
-  {s}•{!} {b}value{!}        {s}—{!} search for occurrences in {c}msg{!} field
-  {s}•{!} {c}field{!}{s}:{!}{b}value{!}  {s}—{!} positive exact search
-  {s}•{!} {c}field{!}{s}:{!}{y}!{!}{b}value{!} {s}—{!} negative exact search
-  {s}•{!} {c}field{!}{s}:{!}{y}~{!}{b}value{!} {s}—{!} search for occurrences
-  {s}•{!} {c}field{!}{s}:{!}{y}>{!}{b}value{!} {s}—{!} equal or greater
-  {s}•{!} {c}field{!}{s}:{!}{y}<{!}{b}value{!} {s}—{!} equal or less`)
+  {s}•{!} {b}value{!}               {s}—{!} search for occurrences in message field
+  {s}•{!} {c}field{!}{s}:{!}{b}value{!}         {s}—{!} positive exact search
+  {s}•{!} {c}field{!}{s}:{!}{y}!{!}{b}value{!}        {s}—{!} negative exact search
+  {s}•{!} {c}field{!}{s}:{!}{y}~{!}{b}value{!}        {s}—{!} search for occurrences
+  {s}•{!} {c}field{!}{s}:{!}{y}!~{!}{b}value{!}       {s}—{!} search for absence of occurrences
+  {s}•{!} {c}field{!}{s}:{!}{y}*{!}             {s}—{!} field exists
+  {s}•{!} {y}!{!}{c}field{!} or {c}field{!}{s}:{!}{y}!*{!}  {s}—{!} field is missing
+  {s}•{!} {c}field{!}{s}:{!}{y}>{!}{b}value{!}        {s}—{!} greater
+  {s}•{!} {c}field{!}{s}:{!}{y}>={!}{b}value{!}       {s}—{!} equal or greater
+  {s}•{!} {c}field{!}{s}:{!}{y}<{!}{b}value{!}        {s}—{!} less
+  {s}•{!} {c}field{!}{s}:{!}{y}<={!}{b}value{!}       {s}—{!} equal or less
+  {s}•{!} {c}field{!}{s}:{!}{b}from{!}{y}..{!}{b}to{!}      {s}—{!} within range {s-}(inclusive){!}
+  {s}•{!} {c}field{!}{s}:{!}{y}/{!}{b}regexp{!}{y}/{!}{b}flags{!} {s}—{!} search using regular expression {s-}(flags: i, m, s){!}
+
+Field can be defined as a path to nested field {s-}(e.g.{!} {c}http.status{!}{s-}){!}.
+
+Values for comparison can be numbers, durations {s-}(e.g.{!} {b}250ms{!}{s-}){!} or sizes {s-}(e.g.{!} {b}1MB{!}{s-}){!}.
+Numeric field values are compared with durations using the unit of filter value.
+
+If record doesn't contain field, only negative conditions {s-}({!}{y}!{!}{s-} and{!} {y}!~{!}{s-}){!} and missing
+field condition match it. All other conditions don't match such records.
+
+Add {y}^{!} before value or condition to make search case-insensitive {s-}(e.g.{!} {c}user{!}{s}:{!}{y}^~{!}{b}john{!}{s-}
+or{!} {y}^{!}{b}timeout{!}{s-}){!}. With {g}--ignore-case{!} option all filters and highlights
+are case-insensitive.
+
+Filters can be combined using {y}AND{!}, {y}OR{!} and {y}NOT{!} operators and grouped with
+parentheses. Filters without operator between them are combined using {y}AND{!}. Operators
+passed as separate arguments can be in any case.`)
 
 	info.AppNameColorTag = colorTagApp
 
-	info.AddOption(OPT_FOLLOW, "Read log stream")
+	info.AddOption(OPT_FOLLOW, "Read log stream {s-}(rotated and truncated files are reopened){!}")
+	info.AddOption(OPT_LINES, "Show only given number of last records {s-}(per source){!}", "num")
 	info.AddOption(OPT_STRICT, "Don't print non-JSON data")
 	info.AddOption(OPT_FIND, "Find and highlight part of message {s}(repeatable){!}")
 	info.AddOption(OPT_NO_PAGER, "Disable pager")
 	info.AddOption(OPT_NO_COLOR, "Disable colors in output")
+	info.AddOption(OPT_CONFIG, "Path to configuration file {s-}(default: $XDG_CONFIG_HOME/lj/config.knf){!}", "file")
+	info.AddOption(OPT_FORMAT, "Log format {s-}("+strings.Join(getFormatsNames(), "/")+"){!}", "format")
+	info.AddOption(OPT_MESSAGE_FIELD, "Message field path {s}(comma-separated){!}", "path")
+	info.AddOption(OPT_LEVEL_FIELD, "Level field path {s}(comma-separated){!}", "path")
+	info.AddOption(OPT_CALLER_FIELD, "Caller field path {s}(comma-separated){!}", "path")
+	info.AddOption(OPT_TIME_FIELD, "Timestamp field path {s}(comma-separated){!}", "path")
+	info.AddOption(OPT_FLATTEN, "Flatten nested objects into {c}parent.child{!} fields")
+	info.AddOption(OPT_EXPAND, "Render large objects and arrays as a tree")
+	info.AddOption(OPT_TIME_LAYOUT, "Timestamp layout {s}(Go layout or unix/unix-ms/unix-us/unix-ns){!}", "layout")
+	info.AddOption(OPT_TIME_FORMAT, "Timestamp display format {s}(strftime-like format, relative or delta){!}", "format")
+	info.AddOption(OPT_TIMEZONE, "Timezone for displaying timestamps {s}(local, UTC or IANA name){!}", "tz")
+	info.AddOption(OPT_SINCE, "Show records not older than given date or duration", "date")
+	info.AddOption(OPT_UNTIL, "Show records not newer than given date or duration {s-}(reading of files stops after the end of range if records are sorted by time){!}", "date")
+	info.AddOption(OPT_IGNORE_CASE, "Ignore case in filters and highlights")
+	info.AddOption(OPT_AFTER, "Print given number of records after matching record", "num")
+	info.AddOption(OPT_BEFORE, "Print given number of records before matching record", "num")
+	info.AddOption(OPT_CONTEXT, "Print given number of records around matching record", "num")
+	info.AddOption(OPT_COUNT, "Print only number of matching records")
+	info.AddOption(OPT_STATS, "Print statistics for matching records")
+	info.AddOption(OPT_GROUP_BY, "Group matching records by values of fields {s}(comma-separated){!}", "field")
+	info.AddOption(OPT_AGG, "Aggregate field values in groups {s}(sum/avg/min/max/pNN, repeatable){!}", "func:field{s-}:unit{!}")
+	info.AddOption(OPT_PATTERNS, "Cluster messages into patterns and print summary")
+	info.AddOption(OPT_DEDUP, "Collapse consecutive records with identical messages")
+	info.AddOption(OPT_DEDUP_SIMILAR, "Collapse consecutive records with similar messages {s-}(ignoring numbers, IDs, IPs and quoted strings){!}")
+	info.AddOption(OPT_MIN_LEVEL, "Show records with given level and above {s-}(trace/debug/info/warn/error/fatal){!}", "level")
 
 	if withSelfUpdate {
 		info.AddOption(OPT_UPDATE, "Update application to the latest version")
@@ -541,14 +816,34 @@ func genUsage() *usage.Info {
 		"Read log file with redirect",
 	)
 
+	info.AddRawExample(
+		"lj api.log db.log 'logs/worker-*.log'",
+		"Read several log files and merge records by timestamp",
+	)
+
+	info.AddRawExample(
+		"lj api.log db.log -- /health",
+		"Read several log files and search for \"/health\" in messages (\"--\" separates files from query)",
+	)
+
+	info.AddRawExample(
+		"lj app.log.1.gz",
+		"Read compressed log file (gzip, bzip2, xz and zstd are supported)",
+	)
+
 	info.AddRawExample(
 		"lj -NP log.json",
 		"Read log file with disabled pager",
 	)
 
 	info.AddRawExample(
-		"tail -100 log.json | lj ",
-		"Read log file from the tail and filter data",
+		"lj -n 100 log.json",
+		"Read the last 100 records from log file",
+	)
+
+	info.AddRawExample(
+		"lj -F -n 20 app.log",
+		"Show the last 20 records from log file and follow it",
 	)
 
 	info.AddRawExample(
@@ -557,10 +852,105 @@ func genUsage() *usage.Info {
 	)
 
 	info.AddRawExample(
-		"lj log.json level:warn 'caller:~app/db.go' 'proc-time:>15'",
+		"lj log.json level:warn 'caller:~app/db.go' 'proc-time:>=15'",
 		"Read log file and filter records",
 	)
 
+	info.AddRawExample(
+		"lj --format logrus log.json",
+		"Read log file in logrus format",
+	)
+
+	info.AddRawExample(
+		"lj -tl '2006-01-02 15:04:05.000' log.json",
+		"Read log file with custom timestamp layout",
+	)
+
+	info.AddRawExample(
+		"lj log.json '(level:error OR level:fatal) AND NOT caller:~vendor/'",
+		"Read log file and filter records using query with operators",
+	)
+
+	info.AddRawExample(
+		"lj log.json 'request_id:/^[a-f0-9]{8}$/' '/timeout|deadline/i'",
+		"Read log file and filter records using regular expressions",
+	)
+
+	info.AddRawExample(
+		"lj -l warn log.json",
+		"Read log file and show only warnings, errors and fatal errors",
+	)
+
+	info.AddRawExample(
+		"lj -B 5 -A 2 log.json level:error",
+		"Read log file and show errors with 5 records before and 2 records after each error",
+	)
+
+	info.AddRawExample(
+		"lj --stats log.json 'caller:~app/'",
+		"Print statistics for records from app package",
+	)
+
+	info.AddRawExample(
+		"lj -g status,caller -a avg:latency -a p99:latency log.json",
+		"Group records by status and caller and calculate average and 99th percentile of latency",
+	)
+
+	info.AddRawExample(
+		"lj --patterns -l warn log.json",
+		"Print patterns of warning and error messages",
+	)
+
+	info.AddRawExample(
+		"kubectl logs -f mypod | lj -F -DS",
+		"Read log from k8s pod and collapse repeated similar messages",
+	)
+
+	info.AddRawExample(
+		"lj log.json 'latency:>250ms' 'bytes:1KB..1MB'",
+		"Read log file and filter records by duration and size",
+	)
+
+	info.AddRawExample(
+		"lj log.json 'error:*' '!trace_id'",
+		"Read log file and show records with error field and without trace_id field",
+	)
+
+	info.AddRawExample(
+		"lj -i -f error log.json 'caller:!~vendor/'",
+		"Read log file, filter records ignoring case and highlight \"error\" in any case",
+	)
+
+	info.AddRawExample(
+		"lj -FL log.json 'http.status:>499' user.id:42",
+		"Read log file with flattened fields and filter records by nested fields",
+	)
+
+	info.AddRawExample(
+		"lj -s 2h -u 15m log.json",
+		"Read records from 2 hours ago to 15 minutes ago",
+	)
+
+	info.AddRawExample(
+		"lj -s yesterday -u '2025-06-01 12:00' log.json",
+		"Read records from the start of yesterday to the given date",
+	)
+
+	info.AddRawExample(
+		"lj -tz UTC -TF '%Y-%m-%d %H:%M:%S' log.json",
+		"Read log file and show timestamps in UTC using custom format",
+	)
+
+	info.AddRawExample(
+		"lj -TF relative log.json",
+		"Read log file and show relative timestamps",
+	)
+
+	info.AddRawExample(
+		"lj -mf message -lf log.level -tf @timestamp log.json",
+		"Read log file with custom fields mapping",
+	)
+
 	return info
 }
 
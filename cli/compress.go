@@ -0,0 +1,62 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Magic bytes of supported compression formats
+var (
+	magicGzip  = []byte{0x1F, 0x8B}
+	magicBzip2 = []byte("BZh")
+	magicXZ    = []byte{0xFD, '7', 'z', 'X', 'Z', 0x00}
+	magicZstd  = []byte{0x28, 0xB5, 0x2F, 0xFD}
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// newDataReader returns reader for given data. If data is compressed with gzip,
+// bzip2, xz or zstd, reader decompresses it.
+func newDataReader(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	header, _ := br.Peek(len(magicXZ))
+
+	switch {
+	case bytes.HasPrefix(header, magicGzip):
+		return gzip.NewReader(br)
+
+	case bytes.HasPrefix(header, magicBzip2):
+		return bzip2.NewReader(br), nil
+
+	case bytes.HasPrefix(header, magicXZ):
+		return xz.NewReader(br)
+
+	case bytes.HasPrefix(header, magicZstd):
+		zr, err := zstd.NewReader(br)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return zr.IOReadCloser(), nil
+	}
+
+	return br, nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
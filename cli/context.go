@@ -0,0 +1,111 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"github.com/essentialkaos/ek/v13/fmtc"
+	"github.com/essentialkaos/ek/v13/options"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// MAX_CONTEXT_SIZE is maximum number of context records
+const MAX_CONTEXT_SIZE = 1000
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Context contains records printed around matching records
+type Context struct {
+	Before int
+	After  int
+
+	buffer    []*Record
+	afterLeft int
+	isPrinted bool
+	isSkipped bool
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// matchContext is context of matching records
+var matchContext *Context
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// configureContext configures context of matching records using options
+func configureContext() {
+	before, after := options.GetI(OPT_CONTEXT), options.GetI(OPT_CONTEXT)
+
+	if options.Has(OPT_BEFORE) {
+		before = options.GetI(OPT_BEFORE)
+	}
+
+	if options.Has(OPT_AFTER) {
+		after = options.GetI(OPT_AFTER)
+	}
+
+	if before == 0 && after == 0 {
+		return
+	}
+
+	matchContext = &Context{Before: before, After: after}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Add adds record to context and prints it with preceding context records if
+// it's matching record or follows matching record. It returns true if record
+// was printed.
+func (c *Context) Add(rec *Record, isMatch bool) bool {
+	if c == nil {
+		if isMatch {
+			printRecord(rec)
+		}
+
+		return isMatch
+	}
+
+	switch {
+	case isMatch:
+		if c.isPrinted && c.isSkipped {
+			dedup.Reset()
+			fmtc.Println("{s-}--{!}")
+		}
+
+		for _, r := range c.buffer {
+			printRecord(r)
+		}
+
+		printRecord(rec)
+
+		c.buffer = c.buffer[:0]
+		c.afterLeft, c.isPrinted, c.isSkipped = c.After, true, false
+
+		return true
+
+	case c.afterLeft > 0:
+		printRecord(rec)
+		c.afterLeft--
+
+		return true
+
+	case c.Before > 0:
+		if len(c.buffer) == c.Before {
+			c.buffer, c.isSkipped = append(c.buffer[:0], c.buffer[1:]...), true
+		}
+
+		c.buffer = append(c.buffer, rec)
+
+	default:
+		c.isSkipped = true
+	}
+
+	return false
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
@@ -0,0 +1,123 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"time"
+
+	"github.com/essentialkaos/ek/v13/fmtc"
+	"github.com/essentialkaos/ek/v13/fmtutil"
+	"github.com/essentialkaos/ek/v13/options"
+	"github.com/essentialkaos/ek/v13/timeutil"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// DEDUP_FLUSH_DELAY is period without new data in follow mode after which info
+// about repeats is printed
+const DEDUP_FLUSH_DELAY = 5 * time.Second
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Dedup contains state of collapsing repeated messages
+type Dedup struct {
+	UseTemplates bool // Compare message templates instead of messages
+
+	key     string
+	level   string
+	repeats int
+	first   time.Time
+	last    time.Time
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// dedup is state of collapsing repeated messages
+var dedup *Dedup
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// configureDedup configures collapsing of repeated messages using options
+func configureDedup() {
+	switch {
+	case options.GetB(OPT_DEDUP_SIMILAR):
+		dedup = &Dedup{UseTemplates: true}
+	case options.GetB(OPT_DEDUP):
+		dedup = &Dedup{}
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IsRepeat returns true if given record repeats previous printed record. If
+// record is not a repeat, info about previous repeats is printed.
+func (d *Dedup) IsRepeat(rec *Record) bool {
+	if d == nil {
+		return false
+	}
+
+	key := rec.Level + ":" + rec.Msg
+
+	if d.UseTemplates {
+		key = rec.Level + ":" + getMessageTemplate(rec.Msg)
+	}
+
+	if rec.Source != nil {
+		key = rec.Source.Name + ":" + key
+	}
+
+	if d.key == key && d.key != "" {
+		d.repeats++
+
+		if !rec.TS.IsZero() {
+			d.last = rec.TS
+		}
+
+		return true
+	}
+
+	d.Flush()
+
+	d.key, d.level, d.first, d.last = key, rec.Level, rec.TS, rec.TS
+
+	return false
+}
+
+// Flush prints info about repeats of the last printed record
+func (d *Dedup) Flush() {
+	if d == nil || d.repeats == 0 {
+		return
+	}
+
+	fmtc.If(!fmtc.DisableColors).Print(markerColors[d.level] + "▎{!}")
+
+	span := d.last.Sub(d.first)
+
+	if d.first.IsZero() || span <= 0 {
+		fmtc.Printfn("{s}  └ ×%s repeated{!}", fmtutil.PrettyNum(d.repeats))
+	} else {
+		fmtc.Printfn(
+			"{s}  └ ×%s repeated over %s{!}",
+			fmtutil.PrettyNum(d.repeats), timeutil.MiniDuration(span, ""),
+		)
+	}
+
+	d.repeats = 0
+}
+
+// Reset flushes info about repeats and resets state
+func (d *Dedup) Reset() {
+	if d == nil {
+		return
+	}
+
+	d.Flush()
+	d.key = ""
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
@@ -0,0 +1,344 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/essentialkaos/ek/v13/fsutil"
+	"github.com/essentialkaos/ek/v13/knf"
+	"github.com/essentialkaos/ek/v13/options"
+	"github.com/essentialkaos/ek/v13/strutil"
+
+	"github.com/tidwall/gjson"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Configuration properties
+const (
+	MAIN_FORMAT    = "main:format"
+	FIELDS_MESSAGE = "fields:message"
+	FIELDS_LEVEL   = "fields:level"
+	FIELDS_CALLER  = "fields:caller"
+	FIELDS_TIME    = "fields:time"
+
+	FIELDS_FLATTEN = "fields:flatten"
+
+	TIME_LAYOUT   = "time:layout"
+	TIME_FORMAT   = "time:format"
+	TIME_TIMEZONE = "time:timezone"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// FieldPaths is a slice with JSON paths of field with special meaning
+type FieldPaths []string
+
+// FieldMap contains paths of fields with special meaning
+type FieldMap struct {
+	Message FieldPaths
+	Level   FieldPaths
+	Caller  FieldPaths
+	Time    FieldPaths
+}
+
+// Record is parsed log record
+type Record struct {
+	Data   gjson.Result
+	Msg    string
+	Level  string
+	Caller string
+	TS     time.Time
+	Fields []Field
+	Source *Source
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// fieldMap is current fields mapping
+var fieldMap = formats[FORMAT_DEFAULT].Fields
+
+// customFields is fields mapping defined by user
+var customFields FieldMap
+
+// logFormat is name of log format
+var logFormat = FORMAT_AUTO
+
+// flattenFields is nested objects flattening flag
+var flattenFields bool
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// configureFields configures fields mapping using configuration file and options
+func configureFields(config *knf.Config) error {
+	logFormat = config.GetS(MAIN_FORMAT, logFormat)
+	customFields.Message = config.GetL(FIELDS_MESSAGE)
+	customFields.Level = config.GetL(FIELDS_LEVEL)
+	customFields.Caller = config.GetL(FIELDS_CALLER)
+	customFields.Time = config.GetL(FIELDS_TIME)
+	flattenFields = config.GetB(FIELDS_FLATTEN)
+
+	if options.Has(OPT_FORMAT) {
+		logFormat = options.GetS(OPT_FORMAT)
+	}
+
+	if options.Has(OPT_MESSAGE_FIELD) {
+		customFields.Message = strutil.Fields(options.GetS(OPT_MESSAGE_FIELD))
+	}
+
+	if options.Has(OPT_LEVEL_FIELD) {
+		customFields.Level = strutil.Fields(options.GetS(OPT_LEVEL_FIELD))
+	}
+
+	if options.Has(OPT_CALLER_FIELD) {
+		customFields.Caller = strutil.Fields(options.GetS(OPT_CALLER_FIELD))
+	}
+
+	if options.Has(OPT_TIME_FIELD) {
+		customFields.Time = strutil.Fields(options.GetS(OPT_TIME_FIELD))
+	}
+
+	if options.GetB(OPT_FLATTEN) {
+		flattenFields = true
+	}
+
+	logFormat = strings.ToLower(logFormat)
+
+	switch {
+	case logFormat == FORMAT_AUTO:
+		applyFormat(FORMAT_DEFAULT)
+	case formats[logFormat] != nil:
+		applyFormat(logFormat)
+	default:
+		return fmt.Errorf("Unknown log format %q", logFormat)
+	}
+
+	return nil
+}
+
+// readConfig reads configuration file
+func readConfig() (*knf.Config, error) {
+	file := options.GetS(OPT_CONFIG)
+
+	if file == "" {
+		file = getDefaultConfigPath()
+
+		if file == "" || !fsutil.IsExist(file) {
+			return nil, nil
+		}
+	}
+
+	config, err := knf.Read(file)
+
+	if err != nil {
+		return nil, fmt.Errorf("Can't read configuration file: %w", err)
+	}
+
+	return config, nil
+}
+
+// getDefaultConfigPath returns path to default configuration file
+func getDefaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, APP, "config.knf")
+}
+
+// parseRecord parses log line and extracts fields with special meaning
+func parseRecord(line string) *Record {
+	data := gjson.Parse(line)
+
+	if !data.IsObject() {
+		return nil
+	}
+
+	rec := &Record{
+		Data:   data,
+		Msg:    strings.TrimRight(fieldMap.Message.Get(data).String(), "\r\n"),
+		Level:  normalizeLevel(fieldMap.Level.Get(data).String()),
+		Caller: formatCaller(fieldMap.Caller.Get(data)),
+		TS:     parseTimestamp(fieldMap.Time.Get(data)),
+	}
+
+	rec.Fields = appendFields(rec.Fields, "", data)
+
+	return rec
+}
+
+// appendFields appends all object fields to given slice
+func appendFields(fields []Field, prefix string, data gjson.Result) []Field {
+	data.ForEach(func(k, v gjson.Result) bool {
+		key := prefix + k.String()
+
+		if fieldMap.IsSpecial(key) {
+			return true
+		}
+
+		switch v.Type {
+		case gjson.String:
+			fields = append(fields, Field{key, fmt.Sprintf("\"%s\"", v.Value()), TYPE_STRING})
+		case gjson.False, gjson.True:
+			fields = append(fields, Field{key, fmt.Sprintf("%t", v.Bool()), TYPE_BOOL})
+		case gjson.Null:
+			fields = append(fields, Field{key, "nil", TYPE_NIL})
+		case gjson.Number:
+			fields = append(fields, Field{key, v.String(), TYPE_NUMBER})
+		default:
+			switch {
+			case flattenFields && v.IsObject():
+				fields = appendFields(fields, key+".", v)
+			case v.IsObject() && fieldMap.HasNestedSpecial(key+"."):
+				v = stripSpecialFields(key+".", v)
+
+				if len(v.Map()) != 0 {
+					fields = append(fields, Field{key, compactJSON(v), TYPE_OBJECT})
+				}
+			case v.IsObject():
+				fields = append(fields, Field{key, compactJSON(v), TYPE_OBJECT})
+			case v.IsArray():
+				fields = append(fields, Field{key, compactJSON(v), TYPE_ARRAY})
+			default:
+				fields = append(fields, Field{key, v.Raw, TYPE_UNKNOWN})
+			}
+		}
+
+		return true
+	})
+
+	return fields
+}
+
+// stripSpecialFields returns copy of object without nested fields with special
+// meaning (prefix is path of object)
+func stripSpecialFields(prefix string, data gjson.Result) gjson.Result {
+	var items []string
+
+	data.ForEach(func(k, v gjson.Result) bool {
+		key := prefix + k.String()
+
+		if fieldMap.IsSpecial(key) {
+			return true
+		}
+
+		if v.IsObject() && fieldMap.HasNestedSpecial(key+".") {
+			v = stripSpecialFields(key+".", v)
+
+			if len(v.Map()) == 0 {
+				return true
+			}
+		}
+
+		items = append(items, k.Raw+":"+v.Raw)
+
+		return true
+	})
+
+	return gjson.Parse("{" + strings.Join(items, ",") + "}")
+}
+
+// getField returns field with given key or path
+func getField(data gjson.Result, path string) gjson.Result {
+	v := data.Get(gjson.Escape(path))
+
+	if v.Exists() || !strings.ContainsAny(path, ".|#*?") {
+		return v
+	}
+
+	return data.Get(path)
+}
+
+// formatCaller formats caller info
+func formatCaller(v gjson.Result) string {
+	if !v.IsObject() {
+		return v.String()
+	}
+
+	file, line := v.Get("file").String(), v.Get("line").String()
+
+	if file == "" || line == "" {
+		return v.Raw
+	}
+
+	return file + ":" + line
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Get returns record field with given key (or path) or message if key is empty
+func (r *Record) Get(key string) gjson.Result {
+	if key == "" {
+		return gjson.Result{Type: gjson.String, Str: r.Msg}
+	}
+
+	return getField(r.Data, key)
+}
+
+// IsSpecial returns true if given key (or path) is a field with special meaning
+func (m FieldMap) IsSpecial(key string) bool {
+	return slices.Contains(m.Message, key) ||
+		slices.Contains(m.Level, key) ||
+		slices.Contains(m.Caller, key) ||
+		slices.Contains(m.Time, key)
+}
+
+// HasNestedSpecial returns true if there is a field with special meaning
+// with given path prefix
+func (m FieldMap) HasNestedSpecial(prefix string) bool {
+	hasPrefix := func(path string) bool { return strings.HasPrefix(path, prefix) }
+
+	return slices.ContainsFunc(m.Message, hasPrefix) ||
+		slices.ContainsFunc(m.Level, hasPrefix) ||
+		slices.ContainsFunc(m.Caller, hasPrefix) ||
+		slices.ContainsFunc(m.Time, hasPrefix)
+}
+
+// With returns copy of fields mapping with paths overridden by given mapping
+func (m FieldMap) With(custom FieldMap) FieldMap {
+	if len(custom.Message) != 0 {
+		m.Message = custom.Message
+	}
+
+	if len(custom.Level) != 0 {
+		m.Level = custom.Level
+	}
+
+	if len(custom.Caller) != 0 {
+		m.Caller = custom.Caller
+	}
+
+	if len(custom.Time) != 0 {
+		m.Time = custom.Time
+	}
+
+	return m
+}
+
+// Get returns value of the first existing field
+func (p FieldPaths) Get(data gjson.Result) gjson.Result {
+	for _, path := range p {
+		v := getField(data, path)
+
+		if v.Exists() {
+			return v
+		}
+	}
+
+	return gjson.Result{}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
@@ -8,139 +8,324 @@ package cli
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
-	"strconv"
+	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/essentialkaos/ek/v13/fmtc"
+
 	"github.com/tidwall/gjson"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 const (
-	COND_POSITIVE uint8 = 0
-	COND_NEGATIVE uint8 = 1
-	COND_CONTAINS uint8 = 2
-	COND_LESS     uint8 = 3
-	COND_GREATER  uint8 = 4
+	COND_POSITIVE     uint8 = 0
+	COND_NEGATIVE     uint8 = 1
+	COND_CONTAINS     uint8 = 2
+	COND_LESS         uint8 = 3
+	COND_GREATER      uint8 = 4
+	COND_REGEXP       uint8 = 5
+	COND_NOT_CONTAINS uint8 = 6
+	COND_EXISTS       uint8 = 7
+	COND_MISSING      uint8 = 8
+	COND_LESS_EQ      uint8 = 9
+	COND_GREATER_EQ   uint8 = 10
+	COND_RANGE        uint8 = 11
+)
+
+// FILTER_IGNORE_CASE is prefix of filter value which makes comparison
+// case-insensitive
+const FILTER_IGNORE_CASE = "^"
+
+// Special filter values for checking field presence
+const (
+	FILTER_ANY     = "*"
+	FILTER_MISSING = "!*"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 // Filter is input filter
 type Filter struct {
-	Key   string
-	Value any
-	Cond  uint8
+	Key        string // Field key (empty key means message field)
+	Value      any
+	Cond       uint8
+	IgnoreCase bool
 }
 
-// Filters is a slice of filters
-type Filters []Filter
+// Condition is filter condition operator
+type Condition struct {
+	Prefix string
+	Cond   uint8
+}
 
 // Highlights is a slice of highlights
-type Highlights []string
+type Highlights []*regexp.Regexp
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-var conditions = map[rune]uint8{
-	'!': COND_NEGATIVE,
-	'~': COND_CONTAINS,
-	'<': COND_LESS,
-	'>': COND_GREATER,
+// conditions is a slice with filter conditions (operators with longer prefix
+// must go first)
+var conditions = []Condition{
+	{"!~", COND_NOT_CONTAINS},
+	{"!", COND_NEGATIVE},
+	{"~", COND_CONTAINS},
+	{"<=", COND_LESS_EQ},
+	{">=", COND_GREATER_EQ},
+	{"<", COND_LESS},
+	{">", COND_GREATER},
 }
 
+// ignoreCase is global case-insensitive mode flag
+var ignoreCase bool
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// parseFilters parses raw filters data
-func parseFilters(filters []string) Filters {
-	var result Filters
+// parseFilter parses raw filter string
+func parseFilter(f string) (Filter, error) {
+	if isRegexpFilter(f) {
+		return parseRegexpFilter("", f)
+	}
+
+	key, value, ok := strings.Cut(f, ":")
 
-	for _, f := range filters {
-		result = append(result, parseFilter(f))
+	if !ok && len(f) > 1 && f[0] == '!' {
+		return Filter{Key: f[1:], Cond: COND_MISSING}, nil
 	}
 
-	return result
-}
+	switch {
+	case !ok:
+		return parseMessageFilter(f), nil
+	case key == "":
+		return Filter{}, fmt.Errorf("field name is empty")
+	case value == "":
+		return Filter{}, fmt.Errorf("value for field %q is empty", key)
+	}
 
-// parseFilter parses raw filter string
-func parseFilter(f string) Filter {
-	key, value, ok := strings.Cut(f, ":")
+	switch {
+	case value == FILTER_ANY:
+		return Filter{Key: key, Cond: COND_EXISTS}, nil
+	case value == FILTER_MISSING:
+		return Filter{Key: key, Cond: COND_MISSING}, nil
+	case isRegexpFilter(value):
+		return parseRegexpFilter(key, value)
+	}
+
+	filter := Filter{Key: key, IgnoreCase: ignoreCase}
+
+	if len(value) > 1 && strings.HasPrefix(value, FILTER_IGNORE_CASE) {
+		filter.IgnoreCase, value = true, value[1:]
+	}
+
+	for _, c := range conditions {
+		if strings.HasPrefix(value, c.Prefix) {
+			filter.Cond, value = c.Cond, value[len(c.Prefix):]
+			break
+		}
+	}
 
-	if !ok || key == "" || value == "" {
-		return Filter{Key: "msg", Value: f, Cond: COND_CONTAINS}
+	if value == "" {
+		return Filter{}, fmt.Errorf("value for field %q is empty", key)
 	}
 
-	filter := Filter{Key: key, Cond: conditions[rune(value[0])]}
+	switch filter.Cond {
+	case COND_GREATER, COND_GREATER_EQ, COND_LESS, COND_LESS_EQ:
+		num, err := parseNumber(value)
+
+		if err != nil {
+			return Filter{}, err
+		}
+
+		filter.Value = num
+
+	case COND_POSITIVE:
+		rng, isRange, err := parseRange(value)
 
-	if filter.Cond != COND_POSITIVE {
-		value = value[1:]
+		switch {
+		case err != nil:
+			return Filter{}, err
+		case isRange:
+			filter.Cond, filter.Value = COND_RANGE, rng
+		default:
+			filter.Value = filter.normalize(value)
+		}
+
+	default:
+		filter.Value = filter.normalize(value)
 	}
 
-	if filter.Cond == COND_GREATER || filter.Cond == COND_LESS {
-		fv, _ := strconv.ParseFloat(value, 64)
-		filter.Value = fv
-	} else {
-		filter.Value = value
+	return filter, nil
+}
+
+// parseMessageFilter parses filter for searching in message field
+func parseMessageFilter(f string) Filter {
+	filter := Filter{Key: "", Cond: COND_CONTAINS, IgnoreCase: ignoreCase}
+
+	if len(f) > 1 && strings.HasPrefix(f, FILTER_IGNORE_CASE) {
+		filter.IgnoreCase, f = true, f[1:]
 	}
 
+	filter.Value = filter.normalize(f)
+
 	return filter
 }
 
-// ////////////////////////////////////////////////////////////////////////////////// //
+// parseRegexpFilter parses filter with regular expression in /pattern/flags format
+func parseRegexpFilter(key, value string) (Filter, error) {
+	sep := strings.LastIndexByte(value, '/')
+	pattern, flags := value[1:sep], value[sep+1:]
 
-// IsMatch checks if json record fields match filters
-func (f Filters) IsMatch(fields map[string]gjson.Result) bool {
-	if len(f) == 0 {
-		return true
+	if ignoreCase && !strings.Contains(flags, "i") {
+		flags += "i"
+	}
+
+	if flags != "" {
+		pattern = "(?" + flags + ")" + pattern
 	}
 
-	for _, ff := range f {
-		jf, ok := fields[ff.Key]
+	re, err := regexp.Compile(pattern)
 
-		if !ok {
-			return false
+	if err != nil {
+		return Filter{}, fmt.Errorf("invalid regular expression %q: %w", value, err)
+	}
+
+	return Filter{Key: key, Value: re, Cond: COND_REGEXP}, nil
+}
+
+// isRegexpFilter returns true if given value is regular expression
+// in /pattern/flags format
+func isRegexpFilter(value string) bool {
+	if len(value) < 3 || value[0] != '/' {
+		return false
+	}
+
+	sep := strings.LastIndexByte(value, '/')
+
+	if sep < 2 {
+		return false
+	}
+
+	return strings.Trim(value[sep+1:], "ims") == ""
+}
+
+// parseHighlights creates highlights from given list of strings
+func parseHighlights(list []string) Highlights {
+	var result Highlights
+
+	for _, h := range list {
+		if h == "" {
+			continue
 		}
 
-		switch ff.Cond {
-		case COND_POSITIVE:
-			if ff.Value.(string) != jf.String() {
-				return false
-			}
-
-		case COND_NEGATIVE:
-			if ff.Value.(string) == jf.String() {
-				return false
-			}
-
-		case COND_CONTAINS:
-			if !strings.Contains(jf.String(), ff.Value.(string)) {
-				return false
-			}
-
-		case COND_GREATER:
-			if ff.Value.(float64) > jf.Float() {
-				return false
-			}
-
-		case COND_LESS:
-			if ff.Value.(float64) < jf.Float() {
-				return false
-			}
+		pattern := regexp.QuoteMeta(h)
+
+		if ignoreCase {
+			pattern = "(?i)" + pattern
 		}
+
+		result = append(result, regexp.MustCompile(pattern))
+	}
+
+	return result
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IsMatch checks if record fields match filter. If record doesn't contain
+// field, only missing field condition and negative conditions match it.
+func (f Filter) IsMatch(rec *Record) bool {
+	jf := rec.Get(f.Key)
+
+	if !jf.Exists() {
+		return f.Cond == COND_MISSING || f.Cond == COND_NEGATIVE ||
+			f.Cond == COND_NOT_CONTAINS
+	}
+
+	switch f.Cond {
+	case COND_EXISTS:
+		return true
+	case COND_MISSING:
+		return false
+	case COND_POSITIVE:
+		return f.isEqual(jf)
+	case COND_NEGATIVE:
+		return !f.isEqual(jf)
+	case COND_CONTAINS:
+		return strings.Contains(f.normalize(jf.String()), f.Value.(string))
+	case COND_NOT_CONTAINS:
+		return !strings.Contains(f.normalize(jf.String()), f.Value.(string))
+	case COND_GREATER, COND_GREATER_EQ, COND_LESS, COND_LESS_EQ:
+		return f.compare(jf)
+	case COND_RANGE:
+		return f.Value.(Range).Contains(jf)
+	case COND_REGEXP:
+		return f.Value.(*regexp.Regexp).MatchString(jf.String())
 	}
 
 	return true
 }
 
+// isEqual returns true if field value is equal to filter value. Values of level
+// fields are also compared as normalized level names.
+func (f Filter) isEqual(v gjson.Result) bool {
+	if f.Value.(string) == f.normalize(v.String()) {
+		return true
+	}
+
+	if !isLevelField(f.Key) {
+		return false
+	}
+
+	level := normalizeLevel(v.String())
+
+	return level != "" && level == normalizeLevel(f.Value.(string))
+}
+
+// compare compares field value with filter value
+func (f Filter) compare(v gjson.Result) bool {
+	num := f.Value.(Number)
+	fv, ok := num.Convert(v)
+
+	if !ok {
+		return false
+	}
+
+	switch f.Cond {
+	case COND_GREATER:
+		return fv > num.Value
+	case COND_GREATER_EQ:
+		return fv >= num.Value
+	case COND_LESS:
+		return fv < num.Value
+	}
+
+	return fv <= num.Value
+}
+
+// normalize converts given value to lower case if filter is case-insensitive
+func (f Filter) normalize(v string) string {
+	if f.IgnoreCase {
+		return strings.ToLower(v)
+	}
+
+	return v
+}
+
 // Apply applies highlights to given message
 func (h Highlights) Apply(msg string) (string, bool) {
 	var found bool
 
-	for _, hh := range h {
-		if strings.Contains(msg, hh) {
-			msg = strings.ReplaceAll(msg, hh, fmtc.Sprint("{#112}{_}"+hh+"{!}"))
-			found = true
+	for _, re := range h {
+		if !re.MatchString(msg) {
+			continue
 		}
+
+		msg = re.ReplaceAllStringFunc(msg, func(m string) string {
+			return fmtc.Sprint("{#112}{_}" + m + "{!}")
+		})
+
+		found = true
 	}
 
 	return msg, found
@@ -0,0 +1,171 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		key    string
+		cond   uint8
+		value  string
+		icase  bool
+	}{
+		{"timeout", "", COND_CONTAINS, "timeout", false},
+		{"^Timeout", "", COND_CONTAINS, "timeout", true},
+		{"level:error", "level", COND_POSITIVE, "error", false},
+		{"level:!error", "level", COND_NEGATIVE, "error", false},
+		{"user:~john", "user", COND_CONTAINS, "john", false},
+		{"user:^~John", "user", COND_CONTAINS, "john", true},
+		{"user:!~john", "user", COND_NOT_CONTAINS, "john", false},
+		{"http.status:500", "http.status", COND_POSITIVE, "500", false},
+		{"trace_id:*", "trace_id", COND_EXISTS, "<nil>", false},
+		{"trace_id:!*", "trace_id", COND_MISSING, "<nil>", false},
+		{"!trace_id", "trace_id", COND_MISSING, "<nil>", false},
+		{"status:>499", "status", COND_GREATER, "{499 0 0}", false},
+		{"status:>=500", "status", COND_GREATER_EQ, "{500 0 0}", false},
+		{"latency:<250ms", "latency", COND_LESS, "{2.5e+08 1 1e+06}", false},
+		{"size:<=1KB", "size", COND_LESS_EQ, "{1024 2 0}", false},
+		{"status:400..499", "status", COND_RANGE, "{{400 0 0} {499 0 0}}", false},
+		{"msg:/time(out)?/i", "msg", COND_REGEXP, "(?i)time(out)?", false},
+		{"/^GET /", "", COND_REGEXP, "^GET ", false},
+		{"url:/api/users", "url", COND_POSITIVE, "/api/users", false},
+	}
+
+	for _, tt := range tests {
+		f, err := parseFilter(tt.filter)
+
+		if err != nil {
+			t.Errorf("parseFilter(%q) returned error: %v", tt.filter, err)
+			continue
+		}
+
+		value := fmt.Sprintf("%v", f.Value)
+
+		if f.Key != tt.key || f.Cond != tt.cond || value != tt.value || f.IgnoreCase != tt.icase {
+			t.Errorf(
+				"parseFilter(%q) = {%q %d %q %t}, want {%q %d %q %t}", tt.filter,
+				f.Key, f.Cond, value, f.IgnoreCase, tt.key, tt.cond, tt.value, tt.icase,
+			)
+		}
+	}
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   string
+	}{
+		{":error", "field name is empty"},
+		{"level:", `value for field "level" is empty`},
+		{"level:!", `value for field "level" is empty`},
+		{"status:>abc", `invalid value "abc" for comparison`},
+		{"status:100..abc", `invalid range "100..abc"`},
+		{"status:500..400", "start of range is greater than its end"},
+		{"size:1KB..10ms", "bounds have different types"},
+		{"msg:/[a/", `invalid regular expression "/[a/"`},
+	}
+
+	for _, tt := range tests {
+		_, err := parseFilter(tt.filter)
+
+		switch {
+		case err == nil:
+			t.Errorf("parseFilter(%q) must return error", tt.filter)
+		case !strings.Contains(err.Error(), tt.want):
+			t.Errorf("parseFilter(%q) error = %q, want %q", tt.filter, err.Error(), tt.want)
+		}
+	}
+}
+
+func TestFilterIsMatch(t *testing.T) {
+	rec := parseRecord(
+		`{"msg":"Request timeout","level":"WARNING","status":504,"latency":"1.5s",` +
+			`"duration":320,"size":"2MB","user":{"name":"John"}}`,
+	)
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{"timeout", true},
+		{"Timeout", false},
+		{"^TIMEOUT", true},
+		{"level:warn", true},
+		{"level:WARNING", true},
+		{"level:!warn", false},
+		{"level:error", false},
+		{"level:~WARN", true},
+		{"user.name:John", true},
+		{"user.name:john", false},
+		{"user.name:^john", true},
+		{"user.name:!~Jo", false},
+		{"user.email:!~Jo", true},
+		{"user.email:!x", true},
+		{"user.email:x", false},
+		{"user:*", true},
+		{"user.email:!*", true},
+		{"!user", false},
+		{"status:>=500", true},
+		{"status:>504", false},
+		{"status:500..599", true},
+		{"latency:>1s", true},
+		{"latency:<=1500ms", true},
+		{"duration:>300ms", true},
+		{"duration:>1000ms", false},
+		{"duration:>1s", true},
+		{"size:>1MB", true},
+		{"size:1MB..1.5MB", false},
+		{"msg:/time(out)?$/", true},
+		{"msg:/^request/", false},
+		{"msg:/^request/i", true},
+	}
+
+	for _, tt := range tests {
+		f, err := parseFilter(tt.filter)
+
+		if err != nil {
+			t.Errorf("parseFilter(%q) returned error: %v", tt.filter, err)
+			continue
+		}
+
+		if got := f.IsMatch(rec); got != tt.want {
+			t.Errorf("IsMatch for filter %q = %t, want %t", tt.filter, got, tt.want)
+		}
+	}
+}
+
+func TestFilterIsMatchNumericLevel(t *testing.T) {
+	rec := parseRecord(`{"msg":"Connection lost","level":50}`)
+
+	for filter, want := range map[string]bool{
+		"level:error": true,
+		"level:50":    true,
+		"level:!err":  false,
+		"level:warn":  false,
+		"level:>=40":  true,
+	} {
+		f, err := parseFilter(filter)
+
+		if err != nil {
+			t.Errorf("parseFilter(%q) returned error: %v", filter, err)
+			continue
+		}
+
+		if got := f.IsMatch(rec); got != want {
+			t.Errorf("IsMatch for filter %q = %t, want %t", filter, got, want)
+		}
+	}
+}
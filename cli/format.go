@@ -0,0 +1,246 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Log formats
+const (
+	FORMAT_AUTO    = "auto"
+	FORMAT_DEFAULT = "default"
+	FORMAT_ZAP     = "zap"
+	FORMAT_LOGRUS  = "logrus"
+	FORMAT_ZEROLOG = "zerolog"
+	FORMAT_SLOG    = "slog"
+	FORMAT_BUNYAN  = "bunyan"
+	FORMAT_PINO    = "pino"
+	FORMAT_ECS     = "ecs"
+	FORMAT_GCP     = "gcp"
+	FORMAT_DOCKER  = "docker"
+)
+
+// FORMAT_SAMPLE_SIZE is number of records used for format detection
+const FORMAT_SAMPLE_SIZE = 20
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Format is log format preset
+type Format struct {
+	Fields FieldMap
+	Detect func(data gjson.Result) bool
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// formats is a map with supported log formats
+var formats = map[string]*Format{
+	FORMAT_DEFAULT: {
+		Fields: FieldMap{
+			Message: FieldPaths{"msg", "log"},
+			Level:   FieldPaths{"level"},
+			Caller:  FieldPaths{"caller"},
+			Time:    FieldPaths{"ts"},
+		},
+	},
+
+	FORMAT_ZAP: {
+		Fields: FieldMap{
+			Message: FieldPaths{"msg"},
+			Level:   FieldPaths{"level"},
+			Caller:  FieldPaths{"caller"},
+			Time:    FieldPaths{"ts"},
+		},
+		Detect: func(data gjson.Result) bool {
+			return hasFields(data, "msg", "level") &&
+				getField(data, "ts").Type == gjson.Number
+		},
+	},
+
+	FORMAT_LOGRUS: {
+		Fields: FieldMap{
+			Message: FieldPaths{"msg"},
+			Level:   FieldPaths{"level"},
+			Caller:  FieldPaths{"file"},
+			Time:    FieldPaths{"time"},
+		},
+		Detect: func(data gjson.Result) bool {
+			return hasFields(data, "msg", "level", "time")
+		},
+	},
+
+	FORMAT_ZEROLOG: {
+		Fields: FieldMap{
+			Message: FieldPaths{"message"},
+			Level:   FieldPaths{"level"},
+			Caller:  FieldPaths{"caller"},
+			Time:    FieldPaths{"time"},
+		},
+		Detect: func(data gjson.Result) bool {
+			return hasFields(data, "message", "level")
+		},
+	},
+
+	FORMAT_SLOG: {
+		Fields: FieldMap{
+			Message: FieldPaths{"msg"},
+			Level:   FieldPaths{"level"},
+			Caller:  FieldPaths{"source"},
+			Time:    FieldPaths{"time"},
+		},
+		Detect: func(data gjson.Result) bool {
+			if !hasFields(data, "msg", "level", "time") {
+				return false
+			}
+
+			level := getField(data, "level").String()
+
+			return getField(data, "source").IsObject() ||
+				slices.Contains([]string{"DEBUG", "INFO", "WARN", "ERROR"}, strings.TrimRight(level, "+-0123456789"))
+		},
+	},
+
+	FORMAT_BUNYAN: {
+		Fields: FieldMap{
+			Message: FieldPaths{"msg"},
+			Level:   FieldPaths{"level"},
+			Caller:  FieldPaths{"src"},
+			Time:    FieldPaths{"time"},
+		},
+		Detect: func(data gjson.Result) bool {
+			return hasFields(data, "v", "msg", "hostname", "pid", "time") &&
+				getField(data, "level").Type == gjson.Number
+		},
+	},
+
+	FORMAT_PINO: {
+		Fields: FieldMap{
+			Message: FieldPaths{"msg"},
+			Level:   FieldPaths{"level"},
+			Caller:  FieldPaths{"caller"},
+			Time:    FieldPaths{"time"},
+		},
+		Detect: func(data gjson.Result) bool {
+			return hasFields(data, "msg") &&
+				getField(data, "level").Type == gjson.Number &&
+				getField(data, "time").Type == gjson.Number
+		},
+	},
+
+	FORMAT_ECS: {
+		Fields: FieldMap{
+			Message: FieldPaths{"message"},
+			Level:   FieldPaths{"log.level"},
+			Caller:  FieldPaths{"log.origin.file.name"},
+			Time:    FieldPaths{"@timestamp"},
+		},
+		Detect: func(data gjson.Result) bool {
+			return hasFields(data, "@timestamp") &&
+				(hasFields(data, "log.level") || hasFields(data, "ecs.version"))
+		},
+	},
+
+	FORMAT_GCP: {
+		Fields: FieldMap{
+			Message: FieldPaths{"message", "textPayload"},
+			Level:   FieldPaths{"severity"},
+			Caller:  FieldPaths{"logging.googleapis.com/sourceLocation"},
+			Time:    FieldPaths{"timestamp", "time"},
+		},
+		Detect: func(data gjson.Result) bool {
+			return hasFields(data, "severity") &&
+				(hasFields(data, "message") || hasFields(data, "textPayload"))
+		},
+	},
+
+	FORMAT_DOCKER: {
+		Fields: FieldMap{
+			Message: FieldPaths{"log"},
+			Level:   FieldPaths{"level"},
+			Caller:  FieldPaths{"caller"},
+			Time:    FieldPaths{"time"},
+		},
+		Detect: func(data gjson.Result) bool {
+			return hasFields(data, "stream", "time") &&
+				getField(data, "log").Type == gjson.String
+		},
+	},
+}
+
+// formatsDetectOrder contains order of formats detection (from the most specific
+// to the most generic one)
+var formatsDetectOrder = []string{
+	FORMAT_DOCKER, FORMAT_BUNYAN, FORMAT_PINO, FORMAT_ECS, FORMAT_GCP,
+	FORMAT_ZAP, FORMAT_ZEROLOG, FORMAT_SLOG, FORMAT_LOGRUS,
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// detectFormat detects log format using given sample of log lines
+func detectFormat(sample []string) string {
+	votes := map[string]int{}
+
+	for _, line := range sample {
+		data := gjson.Parse(line)
+
+		if !data.IsObject() {
+			continue
+		}
+
+		for _, name := range formatsDetectOrder {
+			if formats[name].Detect(data) {
+				votes[name]++
+				break
+			}
+		}
+	}
+
+	result, maxVotes := FORMAT_DEFAULT, 0
+
+	for _, name := range formatsDetectOrder {
+		if votes[name] > maxVotes {
+			result, maxVotes = name, votes[name]
+		}
+	}
+
+	return result
+}
+
+// applyFormat applies fields mapping from format with given name
+func applyFormat(name string) {
+	fieldMap = formats[name].Fields.With(customFields)
+}
+
+// isFormatDetectionRequired returns true if log format must be detected
+func isFormatDetectionRequired() bool {
+	return logFormat == FORMAT_AUTO
+}
+
+// getFormatsNames returns names of all supported formats
+func getFormatsNames() []string {
+	return append([]string{FORMAT_AUTO, FORMAT_DEFAULT}, formatsDetectOrder...)
+}
+
+// hasFields returns true if data contains all given fields
+func hasFields(data gjson.Result, fields ...string) bool {
+	for _, f := range fields {
+		if !getField(data, f).Exists() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
@@ -0,0 +1,345 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/essentialkaos/ek/v13/fmtc"
+	"github.com/essentialkaos/ek/v13/fmtutil"
+	"github.com/essentialkaos/ek/v13/fmtutil/table"
+	"github.com/essentialkaos/ek/v13/options"
+	"github.com/essentialkaos/ek/v13/strutil"
+
+	"github.com/tidwall/gjson"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Aggregation functions
+const (
+	AGG_SUM        = "sum"
+	AGG_AVG        = "avg"
+	AGG_MIN        = "min"
+	AGG_MAX        = "max"
+	AGG_PERCENTILE = "p"
+)
+
+// GROUP_KEY_SEPARATOR is separator used for joining values of group fields
+const GROUP_KEY_SEPARATOR = "\x00"
+
+// AGG_DEFAULT_UNIT is default unit of plain numbers in aggregations of durations
+const AGG_DEFAULT_UNIT = time.Millisecond
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Grouping contains records grouped by values of fields
+type Grouping struct {
+	Fields       []string
+	Aggregations []*Aggregation
+
+	groups map[string]*Group
+}
+
+// Group is group of records with the same values of fields
+type Group struct {
+	Values []string
+	Count  int
+	Data   [][]Number // Values of aggregated fields
+}
+
+// Aggregation is numeric aggregation over field values
+type Aggregation struct {
+	Name       string
+	Func       string
+	Field      string
+	Percentile float64
+	Kind       uint8
+	Unit       float64 // Unit of plain numbers (only for durations, ms by default)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// configureGrouping configures records grouping using options
+func configureGrouping() error {
+	if !options.Has(OPT_GROUP_BY) && !options.Has(OPT_AGG) {
+		return nil
+	}
+
+	switch {
+	case options.GetB(OPT_FOLLOW):
+		return fmt.Errorf("Option %s can't be used with %s", options.F(OPT_GROUP_BY), options.F(OPT_FOLLOW))
+	case collector != nil:
+		return fmt.Errorf("Option %s can't be used with %s or %s", options.F(OPT_GROUP_BY), options.F(OPT_COUNT), options.F(OPT_STATS))
+	}
+
+	grouping := &Grouping{
+		Fields: strutil.Fields(options.GetS(OPT_GROUP_BY)),
+		groups: map[string]*Group{},
+	}
+
+	for _, v := range strutil.Fields(strings.ReplaceAll(options.GetS(OPT_AGG), "\n", " ")) {
+		agg, err := parseAggregation(v)
+
+		if err != nil {
+			return fmt.Errorf("Can't parse %s value: %w", options.F(OPT_AGG), err)
+		}
+
+		grouping.Aggregations = append(grouping.Aggregations, agg)
+	}
+
+	collector = grouping
+
+	return nil
+}
+
+// parseAggregation parses aggregation in func:field or func:field:unit format
+func parseAggregation(v string) (*Aggregation, error) {
+	fn, field, ok := strings.Cut(v, ":")
+
+	if !ok || field == "" {
+		return nil, fmt.Errorf("Invalid aggregation %q (func:field expected)", v)
+	}
+
+	agg := &Aggregation{
+		Name:  v,
+		Func:  strings.ToLower(fn),
+		Field: field,
+		Unit:  float64(AGG_DEFAULT_UNIT),
+	}
+
+	if index := strings.LastIndexByte(field, ':'); index > 0 {
+		unit, isUnit := durationUnits[field[index+1:]]
+
+		if isUnit {
+			agg.Field, agg.Kind, agg.Unit = field[:index], NUMBER_DURATION, unit
+		}
+	}
+
+	switch agg.Func {
+	case AGG_SUM, AGG_AVG, AGG_MIN, AGG_MAX:
+		return agg, nil
+	}
+
+	if !strings.HasPrefix(agg.Func, AGG_PERCENTILE) {
+		return nil, fmt.Errorf("Unknown aggregation function %q", fn)
+	}
+
+	p, err := strconv.ParseFloat(agg.Func[1:], 64)
+
+	if err != nil || p <= 0 || p > 100 {
+		return nil, fmt.Errorf("Invalid percentile %q", fn)
+	}
+
+	agg.Func, agg.Percentile = AGG_PERCENTILE, p
+
+	return agg, nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Add adds record to group
+func (g *Grouping) Add(rec *Record) {
+	values := make([]string, len(g.Fields))
+
+	for i, f := range g.Fields {
+		values[i] = rec.Get(f).String()
+
+		if isLevelField(f) && normalizeLevel(values[i]) != "" {
+			values[i] = normalizeLevel(values[i])
+		}
+	}
+
+	key := strings.Join(values, GROUP_KEY_SEPARATOR)
+	group := g.groups[key]
+
+	if group == nil {
+		group = &Group{Values: values, Data: make([][]Number, len(g.Aggregations))}
+		g.groups[key] = group
+	}
+
+	group.Count++
+
+	for i, agg := range g.Aggregations {
+		num, ok := parseFieldNumber(rec.Get(agg.Field))
+
+		if !ok {
+			continue
+		}
+
+		if agg.Kind == NUMBER_PLAIN && num.Kind != NUMBER_PLAIN {
+			agg.Kind = num.Kind
+		}
+
+		group.Data[i] = append(group.Data[i], num)
+	}
+}
+
+// Print prints groups
+func (g *Grouping) Print() {
+	var headers []string
+	var aligns []uint8
+
+	for _, f := range g.Fields {
+		headers = append(headers, strings.ToUpper(f))
+		aligns = append(aligns, table.AL)
+	}
+
+	headers = append(headers, "RECORDS")
+	aligns = append(aligns, table.AR)
+
+	for _, agg := range g.Aggregations {
+		headers = append(headers, strings.ToUpper(agg.Name))
+		aligns = append(aligns, table.AR)
+	}
+
+	if len(g.groups) == 0 {
+		fmtc.Println("{s-}No matching records{!}")
+		return
+	}
+
+	fmtc.NewLine()
+
+	t := table.NewTable(headers...).SetAlignments(aligns...)
+	t.FullScreen = false
+
+	for _, group := range g.sortedGroups() {
+		var row []any
+
+		for _, v := range group.Values {
+			if v == "" {
+				v = "{s-}—{!}"
+			}
+
+			row = append(row, strutil.Ellipsis(v, 60))
+		}
+
+		row = append(row, fmtutil.PrettyNum(group.Count))
+
+		for i, agg := range g.Aggregations {
+			row = append(row, agg.Format(group.Data[i]))
+		}
+
+		t.Add(row...)
+	}
+
+	t.Render()
+
+	fmtc.NewLine()
+}
+
+// sortedGroups returns groups sorted by number of records
+func (g *Grouping) sortedGroups() []*Group {
+	var result []*Group
+
+	for _, group := range g.groups {
+		result = append(result, group)
+	}
+
+	slices.SortFunc(result, func(a, b *Group) int {
+		return cmp.Or(
+			cmp.Compare(b.Count, a.Count),
+			slices.Compare(a.Values, b.Values),
+		)
+	})
+
+	return result
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Calc calculates aggregation for given values
+func (a *Aggregation) Calc(data []float64) float64 {
+	switch a.Func {
+	case AGG_SUM, AGG_AVG:
+		var sum float64
+
+		for _, v := range data {
+			sum += v
+		}
+
+		if a.Func == AGG_AVG {
+			return sum / float64(len(data))
+		}
+
+		return sum
+
+	case AGG_MIN:
+		return slices.Min(data)
+
+	case AGG_MAX:
+		return slices.Max(data)
+	}
+
+	sorted := slices.Clone(data)
+	slices.Sort(sorted)
+
+	index := int(math.Ceil(a.Percentile/100*float64(len(sorted)))) - 1
+
+	return sorted[max(index, 0)]
+}
+
+// Format calculates aggregation for given values and formats result
+func (a *Aggregation) Format(data []Number) string {
+	values := a.convert(data)
+
+	if len(values) == 0 {
+		return "{s-}—{!}"
+	}
+
+	v := a.Calc(values)
+
+	switch a.Kind {
+	case NUMBER_DURATION:
+		return time.Duration(v).Round(time.Microsecond).String()
+	case NUMBER_SIZE:
+		return fmtutil.PrettySize(v)
+	}
+
+	return fmtutil.PrettyNum(fmtutil.Float(v))
+}
+
+// convert converts given values to numbers of aggregation kind. Plain numbers
+// are converted using aggregation unit, values of other kinds are ignored.
+func (a *Aggregation) convert(data []Number) []float64 {
+	var result []float64
+
+	for _, n := range data {
+		switch {
+		case n.Kind == NUMBER_PLAIN && a.Kind == NUMBER_DURATION:
+			result = append(result, n.Value*a.Unit)
+		case n.Kind == NUMBER_PLAIN, n.Kind == a.Kind:
+			result = append(result, n.Value)
+		}
+	}
+
+	return result
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// parseFieldNumber parses numeric field value (number, duration or size)
+func parseFieldNumber(v gjson.Result) (Number, bool) {
+	switch v.Type {
+	case gjson.Number:
+		return Number{Value: v.Float(), Kind: NUMBER_PLAIN}, true
+	case gjson.String:
+		num, err := parseNumber(strings.TrimSpace(v.Str))
+		return num, err == nil
+	}
+
+	return Number{}, false
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
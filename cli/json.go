@@ -0,0 +1,99 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// JSON_INDENT is indent used for rendering expanded objects and arrays
+const JSON_INDENT = "  "
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// compactJSON returns compact representation of JSON object or array
+func compactJSON(v gjson.Result) string {
+	return gjson.Get(v.Raw, "@ugly").Raw
+}
+
+// colorizeJSON returns compact JSON with color tags
+func colorizeJSON(raw string) string {
+	buf := &bytes.Buffer{}
+	writeJSON(buf, gjson.Parse(raw), "", false)
+	return buf.String()
+}
+
+// colorizeJSONTree returns lines of expanded JSON with color tags
+func colorizeJSONTree(raw string) []string {
+	buf := &bytes.Buffer{}
+	writeJSON(buf, gjson.Parse(raw), "", true)
+	return strings.Split(buf.String(), "\n")
+}
+
+// writeJSON writes JSON value with color tags to given buffer
+func writeJSON(buf *bytes.Buffer, v gjson.Result, indent string, expand bool) {
+	switch {
+	case v.IsObject():
+		writeJSONContainer(buf, v, "{", "}", indent, expand)
+	case v.IsArray():
+		writeJSONContainer(buf, v, "[", "]", indent, expand)
+	case v.Type == gjson.String:
+		buf.WriteString(typeColors[TYPE_STRING] + v.Raw + "{!}")
+	case v.Type == gjson.Number:
+		buf.WriteString(typeColors[TYPE_NUMBER] + v.Raw + "{!}")
+	case v.Type == gjson.True, v.Type == gjson.False:
+		buf.WriteString(typeColors[TYPE_BOOL] + v.Raw + "{!}")
+	case v.Type == gjson.Null:
+		buf.WriteString(typeColors[TYPE_NIL] + v.Raw + "{!}")
+	}
+}
+
+// writeJSONContainer writes JSON object or array with color tags to given buffer
+func writeJSONContainer(buf *bytes.Buffer, v gjson.Result, open, close, indent string, expand bool) {
+	var isEmpty = true
+
+	isObject := v.IsObject()
+
+	buf.WriteString("{s-}" + open + "{!}")
+
+	v.ForEach(func(k, vv gjson.Result) bool {
+		if !isEmpty {
+			buf.WriteString("{s-},{!}")
+		}
+
+		if expand {
+			buf.WriteString("\n" + indent + JSON_INDENT)
+		}
+
+		if isObject {
+			buf.WriteString("{#243}" + k.Raw + "{!}{s-}:{!}")
+
+			if expand {
+				buf.WriteString(" ")
+			}
+		}
+
+		writeJSON(buf, vv, indent+JSON_INDENT, expand)
+		isEmpty = false
+
+		return true
+	})
+
+	if expand && !isEmpty {
+		buf.WriteString("\n" + indent)
+	}
+
+	buf.WriteString("{s-}" + close + "{!}")
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
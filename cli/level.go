@@ -0,0 +1,152 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/essentialkaos/ek/v13/options"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Log levels ordered by severity
+const (
+	LEVEL_UNKNOWN uint8 = iota
+	LEVEL_TRACE
+	LEVEL_DEBUG
+	LEVEL_INFO
+	LEVEL_WARN
+	LEVEL_ERROR
+	LEVEL_FATAL
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// levelNames is a map with level names and aliases
+var levelNames = map[string]uint8{
+	"trace":       LEVEL_TRACE,
+	"verbose":     LEVEL_TRACE,
+	"debug":       LEVEL_DEBUG,
+	"info":        LEVEL_INFO,
+	"information": LEVEL_INFO,
+	"notice":      LEVEL_INFO,
+	"warn":        LEVEL_WARN,
+	"warning":     LEVEL_WARN,
+	"error":       LEVEL_ERROR,
+	"err":         LEVEL_ERROR,
+	"dpanic":      LEVEL_FATAL,
+	"fatal":       LEVEL_FATAL,
+	"critical":    LEVEL_FATAL,
+	"crit":        LEVEL_FATAL,
+	"panic":       LEVEL_FATAL,
+	"alert":       LEVEL_FATAL,
+	"emerg":       LEVEL_FATAL,
+	"emergency":   LEVEL_FATAL,
+}
+
+// syslogLevels contains levels for numeric syslog severities (0-7)
+var syslogLevels = []uint8{
+	LEVEL_FATAL, // emerg
+	LEVEL_FATAL, // alert
+	LEVEL_FATAL, // crit
+	LEVEL_ERROR, // err
+	LEVEL_WARN,  // warning
+	LEVEL_INFO,  // notice
+	LEVEL_INFO,  // info
+	LEVEL_DEBUG, // debug
+}
+
+// levelCodes contains normalized names of levels
+var levelCodes = map[uint8]string{
+	LEVEL_UNKNOWN: "",
+	LEVEL_TRACE:   "trace",
+	LEVEL_DEBUG:   "debug",
+	LEVEL_INFO:    "info",
+	LEVEL_WARN:    "warn",
+	LEVEL_ERROR:   "error",
+	LEVEL_FATAL:   "fatal",
+}
+
+// minLevel is minimal level of records to show
+var minLevel = LEVEL_UNKNOWN
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// configureLevel configures minimal level of records
+func configureLevel() error {
+	if !options.Has(OPT_MIN_LEVEL) {
+		return nil
+	}
+
+	minLevel = parseLevel(options.GetS(OPT_MIN_LEVEL))
+
+	if minLevel == LEVEL_UNKNOWN {
+		return fmt.Errorf("Unknown level %q", options.GetS(OPT_MIN_LEVEL))
+	}
+
+	return nil
+}
+
+// normalizeLevel converts level name, alias or numeric level to normalized
+// level name
+func normalizeLevel(v string) string {
+	return levelCodes[parseLevel(v)]
+}
+
+// parseLevel parses level name, alias (in any case), slog level with offset
+// (INFO+2), numeric syslog severity (0-7) or numeric bunyan/pino level (10-60)
+func parseLevel(v string) uint8 {
+	v = strings.ToLower(strings.TrimSpace(v))
+
+	if level, ok := levelNames[v]; ok {
+		return level
+	}
+
+	i, err := strconv.Atoi(v)
+
+	if err != nil {
+		return levelNames[strings.TrimRight(v, "+-0123456789")]
+	}
+
+	switch {
+	case i < 0:
+		return LEVEL_UNKNOWN
+	case i < len(syslogLevels):
+		return syslogLevels[i]
+	case i >= 60:
+		return LEVEL_FATAL
+	case i >= 50:
+		return LEVEL_ERROR
+	case i >= 40:
+		return LEVEL_WARN
+	case i >= 30:
+		return LEVEL_INFO
+	case i >= 20:
+		return LEVEL_DEBUG
+	case i >= 10:
+		return LEVEL_TRACE
+	}
+
+	return LEVEL_UNKNOWN
+}
+
+// isLevelField returns true if given key is a key of level field
+func isLevelField(key string) bool {
+	return key == "level" || slices.Contains(fieldMap.Level, key)
+}
+
+// isLevelMatch returns true if given level is equal to or higher than minimal level
+func isLevelMatch(level string) bool {
+	return minLevel == LEVEL_UNKNOWN || parseLevel(level) >= minLevel
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
@@ -0,0 +1,67 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"testing"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		value string
+		want  uint8
+	}{
+		{"trace", LEVEL_TRACE},
+		{"VERBOSE", LEVEL_TRACE},
+		{"debug", LEVEL_DEBUG},
+		{"Info", LEVEL_INFO},
+		{"notice", LEVEL_INFO},
+		{"WARNING", LEVEL_WARN},
+		{" warn ", LEVEL_WARN},
+		{"err", LEVEL_ERROR},
+		{"dpanic", LEVEL_FATAL},
+		{"CRITICAL", LEVEL_FATAL},
+		{"INFO+2", LEVEL_INFO},
+		{"ERROR-4", LEVEL_ERROR},
+		{"0", LEVEL_FATAL},
+		{"3", LEVEL_ERROR},
+		{"4", LEVEL_WARN},
+		{"7", LEVEL_DEBUG},
+		{"10", LEVEL_TRACE},
+		{"20", LEVEL_DEBUG},
+		{"30", LEVEL_INFO},
+		{"40", LEVEL_WARN},
+		{"50", LEVEL_ERROR},
+		{"60", LEVEL_FATAL},
+		{"-1", LEVEL_UNKNOWN},
+		{"8", LEVEL_UNKNOWN},
+		{"", LEVEL_UNKNOWN},
+		{"custom", LEVEL_UNKNOWN},
+	}
+
+	for _, tt := range tests {
+		if got := parseLevel(tt.value); got != tt.want {
+			t.Errorf("parseLevel(%q) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeLevel(t *testing.T) {
+	for value, want := range map[string]string{
+		"WARNING": "warn",
+		"50":      "error",
+		"crit":    "fatal",
+		"custom":  "",
+	} {
+		if got := normalizeLevel(value); got != want {
+			t.Errorf("normalizeLevel(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
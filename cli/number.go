@@ -0,0 +1,207 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Kinds of numeric values
+const (
+	NUMBER_PLAIN uint8 = iota
+	NUMBER_DURATION
+	NUMBER_SIZE
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Number is numeric value used for comparison
+type Number struct {
+	Value float64
+	Kind  uint8
+	Unit  float64 // Unit used for numeric field values (only for durations)
+}
+
+// Range is numeric range with inclusive bounds
+type Range struct {
+	From Number
+	To   Number
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// durationUnits is a map with durations of units supported by time.ParseDuration
+var durationUnits = map[string]float64{
+	"ns": float64(time.Nanosecond),
+	"us": float64(time.Microsecond),
+	"µs": float64(time.Microsecond),
+	"μs": float64(time.Microsecond),
+	"ms": float64(time.Millisecond),
+	"s":  float64(time.Second),
+	"m":  float64(time.Minute),
+	"h":  float64(time.Hour),
+}
+
+// sizeUnits is a map with sizes of supported size units
+var sizeUnits = map[string]float64{
+	"b":   1,
+	"kb":  1024,
+	"kib": 1024,
+	"mb":  1024 * 1024,
+	"mib": 1024 * 1024,
+	"gb":  1024 * 1024 * 1024,
+	"gib": 1024 * 1024 * 1024,
+	"tb":  1024 * 1024 * 1024 * 1024,
+	"tib": 1024 * 1024 * 1024 * 1024,
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// parseNumber parses plain number, duration (250ms) or size (1MB)
+func parseNumber(v string) (Number, error) {
+	if v == "" {
+		return Number{}, fmt.Errorf("empty value for comparison")
+	}
+
+	f, ok := parseFloat(v)
+
+	if ok {
+		return Number{Value: f, Kind: NUMBER_PLAIN}, nil
+	}
+
+	d, err := time.ParseDuration(v)
+
+	if err == nil {
+		unit := durationUnits[v[strings.LastIndexAny(v, "0123456789.")+1:]]
+		return Number{Value: float64(d), Kind: NUMBER_DURATION, Unit: unit}, nil
+	}
+
+	size, ok := parseSize(v)
+
+	if ok {
+		return Number{Value: size, Kind: NUMBER_SIZE}, nil
+	}
+
+	return Number{}, fmt.Errorf("invalid value %q for comparison (number, duration or size expected)", v)
+}
+
+// parseRange parses range in from..to format
+func parseRange(v string) (Range, bool, error) {
+	from, to, ok := strings.Cut(v, "..")
+
+	if !ok || from == "" {
+		return Range{}, false, nil
+	}
+
+	fromNum, err := parseNumber(from)
+
+	if err != nil {
+		return Range{}, false, nil
+	}
+
+	toNum, err := parseNumber(to)
+
+	if err != nil {
+		return Range{}, true, fmt.Errorf("invalid range %q: %w", v, err)
+	}
+
+	switch {
+	case fromNum.Kind != toNum.Kind:
+		return Range{}, true, fmt.Errorf("invalid range %q: bounds have different types", v)
+	case fromNum.Value > toNum.Value:
+		return Range{}, true, fmt.Errorf("invalid range %q: start of range is greater than its end", v)
+	}
+
+	return Range{fromNum, toNum}, true, nil
+}
+
+// parseSize parses size with unit (1MB, 512 KiB)
+func parseSize(v string) (float64, bool) {
+	v = strings.ToLower(strings.ReplaceAll(v, " ", ""))
+	num := strings.TrimRight(v, "abcdefghijklmnopqrstuvwxyz")
+	unit, ok := sizeUnits[v[len(num):]]
+
+	if !ok {
+		return 0, false
+	}
+
+	f, ok := parseFloat(num)
+
+	return f * unit, ok
+}
+
+// parseFloat parses finite float number
+func parseFloat(v string) (float64, bool) {
+	f, err := strconv.ParseFloat(v, 64)
+
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return 0, false
+	}
+
+	return f, true
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Convert converts field value to number of the same kind. It returns false if
+// field value can't be converted.
+func (n Number) Convert(v gjson.Result) (float64, bool) {
+	switch v.Type {
+	case gjson.Number:
+		if n.Kind == NUMBER_DURATION {
+			return v.Float() * n.Unit, true
+		}
+
+		return v.Float(), true
+
+	case gjson.String:
+		s := strings.TrimSpace(v.Str)
+
+		switch n.Kind {
+		case NUMBER_DURATION:
+			d, err := time.ParseDuration(s)
+
+			if err == nil {
+				return float64(d), true
+			}
+
+			f, ok := parseFloat(s)
+
+			return f * n.Unit, ok
+
+		case NUMBER_SIZE:
+			f, ok := parseFloat(s)
+
+			if ok {
+				return f, true
+			}
+
+			return parseSize(s)
+		}
+
+		return parseFloat(s)
+	}
+
+	return 0, false
+}
+
+// Contains returns true if given field value is within range
+func (r Range) Contains(v gjson.Result) bool {
+	f, ok := r.From.Convert(v)
+	return ok && f >= r.From.Value && f <= r.To.Value
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
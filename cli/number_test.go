@@ -0,0 +1,112 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Number
+	}{
+		{"42", Number{42, NUMBER_PLAIN, 0}},
+		{"-1.5", Number{-1.5, NUMBER_PLAIN, 0}},
+		{"250ms", Number{float64(250 * time.Millisecond), NUMBER_DURATION, float64(time.Millisecond)}},
+		{"1h30m", Number{float64(90 * time.Minute), NUMBER_DURATION, float64(time.Minute)}},
+		{"1.5s", Number{float64(1500 * time.Millisecond), NUMBER_DURATION, float64(time.Second)}},
+		{"1KB", Number{1024, NUMBER_SIZE, 0}},
+		{"1.5 MiB", Number{1.5 * 1024 * 1024, NUMBER_SIZE, 0}},
+		{"2gb", Number{2 * 1024 * 1024 * 1024, NUMBER_SIZE, 0}},
+	}
+
+	for _, tt := range tests {
+		num, err := parseNumber(tt.value)
+
+		if err != nil {
+			t.Errorf("parseNumber(%q) returned error: %v", tt.value, err)
+			continue
+		}
+
+		if num != tt.want {
+			t.Errorf("parseNumber(%q) = %v, want %v", tt.value, num, tt.want)
+		}
+	}
+
+	for _, v := range []string{"", "abc", "10xb", "NaN", "Inf"} {
+		if _, err := parseNumber(v); err == nil {
+			t.Errorf("parseNumber(%q) must return error", v)
+		}
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		value   string
+		isRange bool
+		isError bool
+	}{
+		{"100..200", true, false},
+		{"1ms..2s", true, false},
+		{"1KB..1MB", true, false},
+		{"error", false, false},
+		{"..100", false, false},
+		{"a..b", false, false},
+		{"100..", true, true},
+		{"100..abc", true, true},
+		{"200..100", true, true},
+		{"1KB..1s", true, true},
+	}
+
+	for _, tt := range tests {
+		_, isRange, err := parseRange(tt.value)
+
+		if isRange != tt.isRange || (err != nil) != tt.isError {
+			t.Errorf(
+				"parseRange(%q) = (%t, %v), want (%t, error: %t)",
+				tt.value, isRange, err, tt.isRange, tt.isError,
+			)
+		}
+	}
+}
+
+func TestNumberConvert(t *testing.T) {
+	ms, _ := parseNumber("100ms")
+	size, _ := parseNumber("1KB")
+	plain, _ := parseNumber("10")
+
+	tests := []struct {
+		num  Number
+		raw  string
+		want float64
+		ok   bool
+	}{
+		{ms, `250`, float64(250 * time.Millisecond), true},
+		{ms, `"1.5s"`, float64(1500 * time.Millisecond), true},
+		{ms, `"12"`, float64(12 * time.Millisecond), true},
+		{ms, `"abc"`, 0, false},
+		{size, `2048`, 2048, true},
+		{size, `"2KB"`, 2048, true},
+		{plain, `"15"`, 15, true},
+		{plain, `true`, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := tt.num.Convert(gjson.Parse(tt.raw))
+
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Convert(%s) = (%v, %t), want (%v, %t)", tt.raw, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/essentialkaos/ek/v13/fmtc"
+	"github.com/essentialkaos/ek/v13/fmtutil"
+	"github.com/essentialkaos/ek/v13/options"
+	"github.com/essentialkaos/ek/v13/strutil"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Patterns contains messages clustered by templates
+type Patterns struct {
+	Total int
+
+	patterns map[string]*Pattern
+}
+
+// Pattern is template of messages
+type Pattern struct {
+	Template string
+	Count    int
+	Levels   map[string]int
+	Example  *Record
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// configurePatterns configures messages clustering using options
+func configurePatterns() error {
+	if !options.GetB(OPT_PATTERNS) {
+		return nil
+	}
+
+	switch {
+	case options.GetB(OPT_FOLLOW):
+		return fmt.Errorf("Option %s can't be used with %s", options.F(OPT_PATTERNS), options.F(OPT_FOLLOW))
+	case collector != nil:
+		return fmt.Errorf(
+			"Option %s can't be used with %s, %s or %s", options.F(OPT_PATTERNS),
+			options.F(OPT_COUNT), options.F(OPT_STATS), options.F(OPT_GROUP_BY),
+		)
+	}
+
+	collector = &Patterns{patterns: map[string]*Pattern{}}
+
+	return nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Add adds record to patterns
+func (p *Patterns) Add(rec *Record) {
+	template := getMessageTemplate(rec.Msg)
+	pattern := p.patterns[template]
+
+	if pattern == nil {
+		pattern = &Pattern{Template: template, Levels: map[string]int{}, Example: rec}
+		p.patterns[template] = pattern
+	}
+
+	p.Total++
+	pattern.Count++
+	pattern.Levels[rec.Level]++
+}
+
+// Print prints patterns
+func (p *Patterns) Print() {
+	if p.Total == 0 {
+		fmtc.Println("{s-}No matching records{!}")
+		return
+	}
+
+	patterns := p.sortedPatterns()
+	countSize := len(fmtutil.PrettyNum(patterns[0].Count))
+
+	fmtc.NewLine()
+
+	for _, pattern := range patterns {
+		pattern.Print(countSize)
+	}
+
+	fmtc.Printfn(
+		"{s-}%s patterns for %s records{!}\n",
+		fmtutil.PrettyNum(len(patterns)), fmtutil.PrettyNum(p.Total),
+	)
+}
+
+// sortedPatterns returns patterns sorted by number of records
+func (p *Patterns) sortedPatterns() []*Pattern {
+	var result []*Pattern
+
+	for _, pattern := range p.patterns {
+		result = append(result, pattern)
+	}
+
+	slices.SortFunc(result, func(a, b *Pattern) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Template, b.Template))
+	})
+
+	return result
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Print prints info about pattern
+func (p *Pattern) Print(countSize int) {
+	level := p.mainLevel()
+	indent := strings.Repeat(" ", countSize+4)
+
+	fmtc.If(!fmtc.DisableColors).Print(markerColors[level] + "▎{!}")
+	fmtc.Printfn(
+		"{*}%"+fmt.Sprint(countSize)+"s{!} {s}×{!} "+textColors[level]+"%s{!}",
+		fmtutil.PrettyNum(p.Count), colorizeTemplate(p.Template, textColors[level]),
+	)
+
+	var levels []string
+
+	for _, l := range []uint8{
+		LEVEL_FATAL, LEVEL_ERROR, LEVEL_WARN, LEVEL_INFO,
+		LEVEL_DEBUG, LEVEL_TRACE, LEVEL_UNKNOWN,
+	} {
+		name := levelCodes[l]
+		count := p.Levels[name]
+
+		if count == 0 {
+			continue
+		}
+
+		if name == "" {
+			name = "unknown"
+		}
+
+		levels = append(levels, fmt.Sprintf(
+			"%s%s{!}{s-}:{!} %s {s-}(%s){!}", textColors[levelCodes[l]], name,
+			fmtutil.PrettyNum(count), fmtutil.PrettyPerc(float64(count)/float64(p.Count)*100),
+		))
+	}
+
+	fmtc.If(!fmtc.DisableColors).Print(markerColors[level] + "▎{!}")
+	fmtc.Println(indent + strings.Join(levels, " {s-}•{!} "))
+
+	ts, _ := formatTimestamp(p.Example.TS)
+
+	fmtc.If(!fmtc.DisableColors).Print(markerColors[level] + "▎{!}")
+	fmtc.Printfn(
+		indent+"{s-}e.g.{!} {s-}[ "+ts+"{s-} ]{!} {s}%s{!}",
+		strutil.Ellipsis(p.Example.Msg, 120),
+	)
+
+	fmtc.NewLine()
+}
+
+// mainLevel returns the most severe level of records with pattern
+func (p *Pattern) mainLevel() string {
+	var result uint8
+
+	for name := range p.Levels {
+		result = max(result, parseLevel(name))
+	}
+
+	return levelCodes[result]
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// colorizeTemplate adds color codes to placeholders in template. Color tags are
+// rendered only for placeholders, so braces in messages are kept as is.
+func colorizeTemplate(template, color string) string {
+	var buf strings.Builder
+	var last int
+
+	for _, m := range placeholderRegex.FindAllStringIndex(template, -1) {
+		buf.WriteString(template[last:m[0]])
+		buf.WriteString(fmtc.Sprint("{#109}" + template[m[0]:m[1]] + "{!}" + color))
+		last = m[1]
+	}
+
+	buf.WriteString(template[last:])
+
+	return buf.String()
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
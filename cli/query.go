@@ -0,0 +1,404 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Query node types
+const (
+	QUERY_FILTER uint8 = iota
+	QUERY_AND
+	QUERY_OR
+	QUERY_NOT
+)
+
+// Query token types
+const (
+	TOKEN_FILTER uint8 = iota
+	TOKEN_AND
+	TOKEN_OR
+	TOKEN_NOT
+	TOKEN_OPEN
+	TOKEN_CLOSE
+	TOKEN_ERROR
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Query is filter query expression
+type Query struct {
+	Type     uint8
+	Filter   Filter
+	Operands []*Query
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// queryToken is query token
+type queryToken struct {
+	Type  uint8
+	Value string
+	Pos   int // Position of token in query (in symbols)
+}
+
+// queryParser is filter query parser
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+	end    int
+}
+
+// QueryError is filter query parsing error
+type QueryError struct {
+	Token string
+	Pos   int
+	Err   error
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// queryOperators is a map with query operators
+var queryOperators = map[string]uint8{
+	"AND": TOKEN_AND,
+	"&&":  TOKEN_AND,
+	"OR":  TOKEN_OR,
+	"||":  TOKEN_OR,
+	"NOT": TOKEN_NOT,
+	"(":   TOKEN_OPEN,
+	")":   TOKEN_CLOSE,
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// parseQuery parses filter query from given arguments
+func parseQuery(args []string) (*Query, error) {
+	tokens := tokenizeQuery(args)
+
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	p := &queryParser{tokens: tokens, end: utf8.RuneCountInString(strings.Join(args, " "))}
+	query, err := p.parseOr()
+
+	if err == nil && p.pos < len(p.tokens) {
+		err = p.newError(p.tokens[p.pos], fmt.Errorf("unexpected %q", p.tokens[p.pos].Value))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Can't parse filter query: %w", err)
+	}
+
+	return query, nil
+}
+
+// tokenizeQuery splits arguments into query tokens
+func tokenizeQuery(args []string) []queryToken {
+	var tokens []queryToken
+	var offset int
+
+	for _, arg := range args {
+		op := getQueryOperator(arg, true)
+
+		switch {
+		case op != TOKEN_FILTER:
+			tokens = append(tokens, queryToken{op, arg, offset})
+		case isQueryExpression(arg):
+			tokens = append(tokens, splitQuery(arg, offset)...)
+		case arg != "":
+			tokens = append(tokens, queryToken{TOKEN_FILTER, arg, offset})
+		}
+
+		offset += utf8.RuneCountInString(arg) + 1
+	}
+
+	return tokens
+}
+
+// getQueryOperator returns type of query operator token or TOKEN_FILTER if given
+// word is not an operator. Operators passed as separate arguments can be in any
+// case, inside of expression only upper case operators are supported.
+func getQueryOperator(word string, anyCase bool) uint8 {
+	if anyCase {
+		word = strings.ToUpper(word)
+	}
+
+	return queryOperators[word]
+}
+
+// isQueryExpression returns true if given argument contains query expression
+// and not just a single filter
+func isQueryExpression(arg string) bool {
+	if strings.HasPrefix(arg, "(") || strings.HasSuffix(arg, ")") {
+		return true
+	}
+
+	for _, word := range strings.Fields(arg) {
+		if getQueryOperator(word, false) != TOKEN_FILTER {
+			return true
+		}
+	}
+
+	return false
+}
+
+// splitQuery splits query expression into tokens (offset is position of
+// expression in query)
+func splitQuery(expr string, offset int) []queryToken {
+	var tokens []queryToken
+	var depth, pos, start int
+	var inQuotes, isQuoted bool
+
+	buf := &strings.Builder{}
+
+	flush := func() {
+		if buf.Len() == 0 && !isQuoted {
+			return
+		}
+
+		op := getQueryOperator(buf.String(), false)
+
+		if isQuoted || op == TOKEN_FILTER || op == TOKEN_OPEN || op == TOKEN_CLOSE {
+			tokens = append(tokens, queryToken{TOKEN_FILTER, buf.String(), offset + start})
+		} else {
+			tokens = append(tokens, queryToken{op, buf.String(), offset + start})
+		}
+
+		buf.Reset()
+		depth, isQuoted = 0, false
+	}
+
+	for _, r := range expr {
+		if buf.Len() == 0 && !isQuoted {
+			start = pos
+		}
+
+		switch {
+		case r == '"':
+			inQuotes, isQuoted = !inQuotes, true
+		case inQuotes:
+			buf.WriteRune(r)
+		case unicode.IsSpace(r):
+			flush()
+		case r == '(' && buf.Len() == 0 && !isQuoted:
+			tokens = append(tokens, queryToken{TOKEN_OPEN, "(", offset + pos})
+		case r == '(':
+			buf.WriteRune(r)
+			depth++
+		case r == ')' && depth > 0:
+			buf.WriteRune(r)
+			depth--
+		case r == ')':
+			flush()
+			tokens = append(tokens, queryToken{TOKEN_CLOSE, ")", offset + pos})
+		default:
+			buf.WriteRune(r)
+		}
+
+		pos++
+	}
+
+	if inQuotes {
+		tokens = append(tokens, queryToken{TOKEN_ERROR, buf.String(), offset + start})
+		return tokens
+	}
+
+	flush()
+
+	return tokens
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IsMatch checks if record matches query
+func (q *Query) IsMatch(rec *Record) bool {
+	if q == nil {
+		return true
+	}
+
+	switch q.Type {
+	case QUERY_AND:
+		for _, qq := range q.Operands {
+			if !qq.IsMatch(rec) {
+				return false
+			}
+		}
+
+		return true
+
+	case QUERY_OR:
+		for _, qq := range q.Operands {
+			if qq.IsMatch(rec) {
+				return true
+			}
+		}
+
+		return false
+
+	case QUERY_NOT:
+		return !q.Operands[0].IsMatch(rec)
+	}
+
+	return q.Filter.IsMatch(rec)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// parseOr parses sequence of expressions joined with OR operator
+func (p *queryParser) parseOr() (*Query, error) {
+	query, err := p.parseAnd()
+
+	if err != nil {
+		return nil, err
+	}
+
+	operands := []*Query{query}
+
+	for p.is(TOKEN_OR) {
+		p.pos++
+
+		query, err = p.parseAnd()
+
+		if err != nil {
+			return nil, err
+		}
+
+		operands = append(operands, query)
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+
+	return &Query{Type: QUERY_OR, Operands: operands}, nil
+}
+
+// parseAnd parses sequence of expressions joined with AND operator (AND is
+// used by default if there is no operator between expressions)
+func (p *queryParser) parseAnd() (*Query, error) {
+	query, err := p.parseNot()
+
+	if err != nil {
+		return nil, err
+	}
+
+	operands := []*Query{query}
+
+	for p.pos < len(p.tokens) && !p.is(TOKEN_OR) && !p.is(TOKEN_CLOSE) {
+		if p.is(TOKEN_AND) {
+			p.pos++
+		}
+
+		query, err = p.parseNot()
+
+		if err != nil {
+			return nil, err
+		}
+
+		operands = append(operands, query)
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+
+	return &Query{Type: QUERY_AND, Operands: operands}, nil
+}
+
+// parseNot parses negated expression
+func (p *queryParser) parseNot() (*Query, error) {
+	if !p.is(TOKEN_NOT) {
+		return p.parsePrimary()
+	}
+
+	p.pos++
+
+	query, err := p.parseNot()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Query{Type: QUERY_NOT, Operands: []*Query{query}}, nil
+}
+
+// parsePrimary parses single filter or group of expressions
+func (p *queryParser) parsePrimary() (*Query, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, &QueryError{Pos: p.end, Err: fmt.Errorf("unexpected end of query")}
+	}
+
+	token := p.tokens[p.pos]
+	p.pos++
+
+	switch token.Type {
+	case TOKEN_FILTER:
+		filter, err := parseFilter(token.Value)
+
+		if err != nil {
+			return nil, p.newError(token, err)
+		}
+
+		return &Query{Type: QUERY_FILTER, Filter: filter}, nil
+
+	case TOKEN_OPEN:
+		query, err := p.parseOr()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.is(TOKEN_CLOSE) {
+			return nil, p.newError(token, fmt.Errorf("missing closing parenthesis"))
+		}
+
+		p.pos++
+
+		return query, nil
+
+	case TOKEN_ERROR:
+		return nil, p.newError(token, fmt.Errorf("missing closing quote"))
+	}
+
+	return nil, p.newError(token, fmt.Errorf("unexpected %q", token.Value))
+}
+
+// is returns true if current token has given type
+func (p *queryParser) is(typ uint8) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].Type == typ
+}
+
+// newError creates new parsing error for given token
+func (p *queryParser) newError(token queryToken, err error) *QueryError {
+	return &QueryError{Token: token.Value, Pos: token.Pos, Err: err}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Error returns error message
+func (e *QueryError) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("%v at position %d", e.Err, e.Pos+1)
+	}
+
+	return fmt.Sprintf("%v in %q at position %d", e.Err, e.Token, e.Pos+1)
+}
+
+// Unwrap returns original error
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
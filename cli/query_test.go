@@ -0,0 +1,140 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{nil, "<nil>"},
+		{[]string{"timeout"}, `:2:timeout`},
+		{[]string{"user not found"}, `:2:user not found`},
+		{[]string{"not found"}, `:2:not found`},
+		{[]string{"a", "b"}, `AND(:2:a, :2:b)`},
+		{[]string{"level:error", "or", "level:warn"}, `OR(level:0:error, level:0:warn)`},
+		{[]string{"level:error", "Or", "NOT", "a"}, `OR(level:0:error, NOT(:2:a))`},
+		{[]string{"level:error OR level:warn"}, `OR(level:0:error, level:0:warn)`},
+		{[]string{"level:error || level:warn && b"}, `OR(level:0:error, AND(level:0:warn, :2:b))`},
+		{[]string{"level:error or level:warn"}, `level:0:error or level:warn`},
+		{
+			[]string{"(level:error OR level:fatal) AND NOT caller:~vendor/"},
+			`AND(OR(level:0:error, level:0:fatal), NOT(caller:2:vendor/))`,
+		},
+		{[]string{"(", "a", "OR", "b", ")", "c"}, `AND(OR(:2:a, :2:b), :2:c)`},
+		{[]string{"NOT", "NOT", "a"}, `NOT(NOT(:2:a))`},
+		{[]string{`msg:"a OR b" OR c`}, `OR(msg:0:a OR b, :2:c)`},
+		{[]string{"fn(x)"}, `:2:fn(x)`},
+	}
+
+	for _, tt := range tests {
+		q, err := parseQuery(tt.args)
+
+		if err != nil {
+			t.Errorf("parseQuery(%q) returned error: %v", tt.args, err)
+			continue
+		}
+
+		if got := dumpQuery(q); got != tt.want {
+			t.Errorf("parseQuery(%q) = %s, want %s", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"(level:error"}, `missing closing parenthesis in "(" at position 1`},
+		{[]string{"level:error", "OR"}, `unexpected end of query at position 15`},
+		{[]string{"a", ")"}, `unexpected ")" in ")" at position 3`},
+		{[]string{"a", "AND", "OR", "b"}, `unexpected "OR" in "OR" at position 7`},
+		{[]string{"level:"}, `value for field "level" is empty in "level:" at position 1`},
+		{[]string{"a", "foo:>abc"}, `in "foo:>abc" at position 3`},
+		{[]string{"a OR :b"}, `field name is empty in ":b" at position 6`},
+		{[]string{`(msg:"abc)`}, `missing closing quote in "msg:abc)" at position 2`},
+		{[]string{"msg:/(/"}, `invalid regular expression "/(/"`},
+	}
+
+	for _, tt := range tests {
+		_, err := parseQuery(tt.args)
+
+		switch {
+		case err == nil:
+			t.Errorf("parseQuery(%q) must return error", tt.args)
+		case !strings.HasPrefix(err.Error(), "Can't parse filter query: "):
+			t.Errorf("parseQuery(%q) returned unexpected error: %v", tt.args, err)
+		case !strings.Contains(err.Error(), tt.want):
+			t.Errorf("parseQuery(%q) error = %q, want %q", tt.args, err.Error(), tt.want)
+		}
+	}
+}
+
+func TestQueryIsMatch(t *testing.T) {
+	rec := parseRecord(`{"msg":"user not found","level":"WARNING","status":404}`)
+
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"user not found"}, true},
+		{[]string{"not found"}, true},
+		{[]string{"level:warn", "and", "status:>=400"}, true},
+		{[]string{"level:error OR status:404"}, true},
+		{[]string{"NOT level:warn"}, false},
+		{[]string{"(level:error OR level:fatal) AND status:404"}, false},
+	}
+
+	for _, tt := range tests {
+		q, err := parseQuery(tt.args)
+
+		if err != nil {
+			t.Errorf("parseQuery(%q) returned error: %v", tt.args, err)
+			continue
+		}
+
+		if got := q.IsMatch(rec); got != tt.want {
+			t.Errorf("IsMatch for query %q = %t, want %t", tt.args, got, tt.want)
+		}
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// dumpQuery returns string representation of query
+func dumpQuery(q *Query) string {
+	if q == nil {
+		return "<nil>"
+	}
+
+	var operands []string
+
+	for _, qq := range q.Operands {
+		operands = append(operands, dumpQuery(qq))
+	}
+
+	switch q.Type {
+	case QUERY_AND:
+		return "AND(" + strings.Join(operands, ", ") + ")"
+	case QUERY_OR:
+		return "OR(" + strings.Join(operands, ", ") + ")"
+	case QUERY_NOT:
+		return "NOT(" + strings.Join(operands, ", ") + ")"
+	}
+
+	return fmt.Sprintf("%s:%d:%v", q.Filter.Key, q.Filter.Cond, q.Filter.Value)
+}
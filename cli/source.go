@@ -0,0 +1,469 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/essentialkaos/ek/v13/fmtc"
+	"github.com/essentialkaos/ek/v13/fsutil"
+	"github.com/essentialkaos/ek/v13/options"
+	"github.com/essentialkaos/ek/v13/strutil"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// POLL_INTERVAL is interval of checking for new data if file changes can't be
+// watched
+const POLL_INTERVAL = 50 * time.Millisecond
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Source is source of log records
+type Source struct {
+	Name  string // Path to file
+	Label string // Short label used for marking records
+	Color string // Color tag of label
+
+	fd      *os.File
+	reader  io.Reader
+	scanner *bufio.Scanner
+	sample  []string  // Lines read for format detection
+	line    string    // Current line
+	ts      time.Time // Timestamp of current line
+	isDone  bool
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// sourceLine is line read from source
+type sourceLine struct {
+	Source *Source
+	Line   string
+	Notice string // Notice about source state change (rotation or truncation)
+	IsEnd  bool   // Source reached the end of data and can't be followed
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// labelColors is a slice with colors of source labels
+var labelColors = []string{
+	"{#75}", "{#176}", "{#179}", "{#79}", "{#210}", "{#147}", "{#186}", "{#116}",
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getSourceFiles returns paths of files with data and filters. If data is
+// passed through stdin, list of files is empty. Files can be separated from
+// filters using "--".
+func getSourceFiles(args options.Arguments) ([]string, []string, error) {
+	list := args.Strings()
+	sep := slices.Index(list, "--")
+
+	if hasStdinData() {
+		if sep != -1 {
+			list = slices.Delete(list, sep, sep+1)
+		}
+
+		return nil, list, nil
+	}
+
+	if sep != -1 {
+		files, err := getSeparatedFiles(list[:sep])
+		return files, list[sep+1:], err
+	}
+
+	var result []string
+	var index int
+
+	for index < len(list) {
+		files := findFiles(filepath.Clean(list[index]))
+
+		if len(files) == 0 {
+			switch {
+			case index == 0:
+				files = []string{filepath.Clean(list[index])}
+			case isPathLike(list[index]):
+				return nil, nil, fmt.Errorf("Can't find files matching %q", list[index])
+			default:
+				return result, list[index:], nil
+			}
+		}
+
+		result = append(result, files...)
+		index++
+	}
+
+	return result, nil, nil
+}
+
+// getSeparatedFiles returns paths of files for arguments placed before "--"
+func getSeparatedFiles(args []string) ([]string, error) {
+	var result []string
+
+	for _, arg := range args {
+		files := findFiles(filepath.Clean(arg))
+
+		switch {
+		case len(files) != 0:
+			result = append(result, files...)
+		case strings.ContainsAny(arg, "*?["):
+			return nil, fmt.Errorf("Can't find files matching %q", arg)
+		default:
+			result = append(result, filepath.Clean(arg))
+		}
+	}
+
+	return result, nil
+}
+
+// openSources opens given files as data sources. If there are no files, data
+// is read from stdin.
+func openSources(files []string) ([]*Source, error) {
+	if len(files) == 0 {
+		src, err := newSource("", os.Stdin)
+
+		if err != nil {
+			return nil, fmt.Errorf("Can't read data from stdin: %w", err)
+		}
+
+		return []*Source{src}, nil
+	}
+
+	var sources []*Source
+
+	for _, file := range files {
+		fd, err := os.OpenFile(file, os.O_RDONLY, 0)
+
+		if err != nil {
+			closeSources(sources)
+			return nil, fmt.Errorf("Can't open file for reading: %w", err)
+		}
+
+		src, err := newSource(file, fd)
+
+		if err != nil {
+			fd.Close()
+			closeSources(sources)
+			return nil, fmt.Errorf("Can't read data from %s: %w", file, err)
+		}
+
+		sources = append(sources, src)
+	}
+
+	if len(sources) > 1 {
+		setSourcesLabels(sources)
+	}
+
+	return sources, nil
+}
+
+// newSource creates new source
+func newSource(name string, fd *os.File) (*Source, error) {
+	r, err := newDataReader(fd)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Source{Name: name, fd: fd, reader: r, scanner: bufio.NewScanner(r)}, nil
+}
+
+// findFiles returns paths of regular files matching given path or glob
+func findFiles(path string) []string {
+	var result []string
+
+	matches := []string{path}
+
+	if strings.ContainsAny(path, "*?[") {
+		matches, _ = filepath.Glob(path)
+	}
+
+	for _, file := range matches {
+		info, err := os.Stat(file)
+
+		if err == nil && info.Mode().IsRegular() {
+			result = append(result, file)
+		}
+	}
+
+	return result
+}
+
+// isPathLike returns true if given argument is clearly a path to file or glob
+// (i.e. it has existing directory part) and not a filter
+func isPathLike(arg string) bool {
+	if strings.ContainsAny(arg, ": \t") || isRegexpFilter(arg) {
+		return false
+	}
+
+	dir := filepath.Dir(arg)
+
+	return dir != "." && dir != "/" && fsutil.IsDir(dir)
+}
+
+// setSourcesLabels sets unique labels and colors for sources
+func setSourcesLabels(sources []*Source) {
+	var maxSize int
+
+	names := map[string]int{}
+
+	for _, s := range sources {
+		s.Label, _, _ = strings.Cut(filepath.Base(s.Name), ".")
+		names[s.Label]++
+	}
+
+	for i, s := range sources {
+		if s.Label == "" || names[s.Label] > 1 {
+			s.Label = s.Name
+		}
+
+		s.Color = labelColors[i%len(labelColors)]
+		maxSize = max(maxSize, strutil.Len(s.Label))
+	}
+
+	for _, s := range sources {
+		s.Label += strings.Repeat(" ", maxSize-strutil.Len(s.Label))
+	}
+}
+
+// closeSources closes all given sources
+func closeSources(sources []*Source) {
+	for _, s := range sources {
+		s.Close()
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ReadSample reads given number of non-empty lines for format detection
+func (s *Source) ReadSample(size int) []string {
+	for len(s.sample) < size && s.scanner.Scan() {
+		line := strings.TrimSpace(s.scanner.Text())
+
+		if line != "" {
+			s.sample = append(s.sample, line)
+		}
+	}
+
+	return s.sample
+}
+
+// Next reads next non-empty line. It returns false if there is no more data.
+func (s *Source) Next() bool {
+	if s.isDone {
+		return false
+	}
+
+	for {
+		var line string
+
+		switch {
+		case len(s.sample) != 0:
+			line, s.sample = s.sample[0], s.sample[1:]
+		case s.scanner.Scan():
+			line = strings.TrimSpace(s.scanner.Text())
+		default:
+			s.isDone, s.line = true, ""
+			return false
+		}
+
+		if line != "" {
+			s.line = line
+			return true
+		}
+	}
+}
+
+// Follow reads new lines from source and sends them to given channel. If
+// source is a file, it will be reopened after rotation or truncation. If
+// source is a pipe, following stops at the end of data.
+func (s *Source) Follow(lines chan<- sourceLine) {
+	var buf string
+
+	r := bufio.NewReader(s.reader)
+	watcher := newFileWatcher(s.Name)
+	isFollowable := s.isRegularFile()
+
+	defer watcher.Close()
+
+	for _, line := range s.sample {
+		lines <- sourceLine{Source: s, Line: line}
+	}
+
+	s.sample = nil
+
+	for {
+		buf = s.readLines(r, buf, lines)
+
+		if !isFollowable {
+			if buf != "" {
+				lines <- sourceLine{Source: s, Line: strings.TrimRight(buf, "\r\n")}
+			}
+
+			lines <- sourceLine{Source: s, IsEnd: true}
+
+			return
+		}
+
+		event := s.checkFile()
+
+		if event == "" {
+			watcher.Wait()
+			continue
+		}
+
+		if event == "rotated" {
+			// Read data written to the old file after the last read
+			buf = s.readLines(r, buf, lines)
+
+			if !s.reopen() {
+				watcher.Wait()
+				continue
+			}
+		}
+
+		if buf != "" {
+			lines <- sourceLine{Source: s, Line: strings.TrimRight(buf, "\r\n")}
+			buf = ""
+		}
+
+		r = bufio.NewReader(s.reader)
+		watcher.Add(s.Name)
+		lines <- sourceLine{Source: s, Notice: filepath.Base(s.Name) + " " + event}
+	}
+}
+
+// isRegularFile returns true if source is a regular file
+func (s *Source) isRegularFile() bool {
+	info, err := s.fd.Stat()
+	return err == nil && info.Mode().IsRegular()
+}
+
+// readLines sends all complete lines from given reader to channel and returns
+// incomplete rest of data
+func (s *Source) readLines(r *bufio.Reader, buf string, lines chan<- sourceLine) string {
+	for {
+		line, err := r.ReadString('\n')
+		buf += line
+
+		if err != nil {
+			return buf
+		}
+
+		lines <- sourceLine{Source: s, Line: strings.TrimRight(buf, "\r\n")}
+		buf = ""
+	}
+}
+
+// checkFile checks if followed file was rotated or truncated. Truncated file
+// is read from the beginning, rotated file must be reopened using reopen
+// method. It returns name of event if any.
+func (s *Source) checkFile() string {
+	if s.Name == "" {
+		return ""
+	}
+
+	info, err := os.Stat(s.Name)
+
+	// File may be temporarily missing during rotation
+	if err != nil {
+		return ""
+	}
+
+	fdInfo, err := s.fd.Stat()
+
+	if err != nil {
+		return ""
+	}
+
+	if !os.SameFile(info, fdInfo) {
+		return "rotated"
+	}
+
+	pos, err := s.fd.Seek(0, io.SeekCurrent)
+
+	if err != nil || info.Size() >= pos {
+		return ""
+	}
+
+	_, err = s.fd.Seek(0, io.SeekStart)
+
+	if err != nil {
+		return ""
+	}
+
+	r, err := newDataReader(s.fd)
+
+	if err != nil {
+		return ""
+	}
+
+	s.reader = r
+
+	return "truncated"
+}
+
+// reopen replaces descriptor of rotated file with descriptor of new file. It
+// returns true if file was reopened.
+func (s *Source) reopen() bool {
+	fd, err := os.OpenFile(s.Name, os.O_RDONLY, 0)
+
+	if err != nil {
+		return false
+	}
+
+	r, err := newDataReader(fd)
+
+	if err != nil {
+		fd.Close()
+		return false
+	}
+
+	s.fd.Close()
+	s.fd, s.reader = fd, r
+
+	return true
+}
+
+// UpdateTimestamp updates timestamp of current line (lines without timestamp
+// get timestamp of previous line)
+func (s *Source) UpdateTimestamp() {
+	rec := parseRecord(s.line)
+
+	if rec != nil && !rec.TS.IsZero() {
+		s.ts = rec.TS
+	}
+}
+
+// PrintLabel prints source label and returns its size
+func (s *Source) PrintLabel() int {
+	if s == nil || s.Label == "" {
+		return 0
+	}
+
+	fmtc.Print(s.Color + s.Label + "{!} ")
+
+	return strutil.Len(s.Label) + 1
+}
+
+// Close closes source
+func (s *Source) Close() {
+	if s.fd != nil {
+		s.fd.Close()
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
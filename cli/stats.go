@@ -0,0 +1,233 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/essentialkaos/ek/v13/fmtc"
+	"github.com/essentialkaos/ek/v13/fmtutil"
+	"github.com/essentialkaos/ek/v13/fmtutil/table"
+	"github.com/essentialkaos/ek/v13/options"
+	"github.com/essentialkaos/ek/v13/strutil"
+	"github.com/essentialkaos/ek/v13/timeutil"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// STATS_TOP_SIZE is number of entries in top lists
+const STATS_TOP_SIZE = 10
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Stats contains statistics of matching records
+type Stats struct {
+	Total    int
+	Levels   map[string]int
+	Callers  map[string]int
+	Messages map[string]int
+	First    time.Time
+	Last     time.Time
+
+	countOnly bool
+}
+
+// Collector is collector of matching records used instead of rendering
+type Collector interface {
+	Add(rec *Record)
+	Print()
+}
+
+// StatsEntry is entry of statistics top list
+type StatsEntry struct {
+	Name  string
+	Count int
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// collector is collector of matching records
+var collector Collector
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// configureStats configures statistics collection using options
+func configureStats() error {
+	if !options.GetB(OPT_COUNT) && !options.GetB(OPT_STATS) {
+		return nil
+	}
+
+	if options.GetB(OPT_FOLLOW) {
+		opt := OPT_COUNT
+
+		if options.GetB(OPT_STATS) {
+			opt = OPT_STATS
+		}
+
+		return fmt.Errorf("Option %s can't be used with %s", options.F(opt), options.F(OPT_FOLLOW))
+	}
+
+	collector = &Stats{
+		Levels:    map[string]int{},
+		Callers:   map[string]int{},
+		Messages:  map[string]int{},
+		countOnly: !options.GetB(OPT_STATS),
+	}
+
+	return nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Add adds record to statistics
+func (s *Stats) Add(rec *Record) {
+	s.Total++
+
+	if s.countOnly {
+		return
+	}
+
+	s.Levels[rec.Level]++
+	s.Messages[rec.Msg]++
+
+	if rec.Caller != "" {
+		s.Callers[rec.Caller]++
+	}
+
+	if rec.TS.IsZero() {
+		return
+	}
+
+	if s.First.IsZero() || rec.TS.Before(s.First) {
+		s.First = rec.TS
+	}
+
+	if s.Last.IsZero() || rec.TS.After(s.Last) {
+		s.Last = rec.TS
+	}
+}
+
+// Rate returns number of records per minute
+func (s *Stats) Rate() float64 {
+	d := s.Last.Sub(s.First)
+
+	if d <= 0 {
+		return 0
+	}
+
+	return float64(s.Total) / d.Minutes()
+}
+
+// Print prints statistics
+func (s *Stats) Print() {
+	if s.countOnly {
+		fmt.Println(s.Total)
+		return
+	}
+
+	fmtc.NewLine()
+	fmtc.Printfn(" {*}Records:{!} %s", fmtutil.PrettyNum(s.Total))
+
+	if !s.First.IsZero() {
+		fmtc.Printfn(" {*}First:{!}   %s", timeutil.Format(s.First.In(timeZone), TIME_FORMAT_DEFAULT))
+		fmtc.Printfn(" {*}Last:{!}    %s", timeutil.Format(s.Last.In(timeZone), TIME_FORMAT_DEFAULT))
+		fmtc.Printfn(
+			" {*}Period:{!}  %s {s-}(%s/min){!}",
+			timeutil.MiniDuration(s.Last.Sub(s.First), ""),
+			fmtutil.PrettyNum(fmtutil.Float(s.Rate())),
+		)
+	}
+
+	if s.Total == 0 {
+		fmtc.NewLine()
+		return
+	}
+
+	fmtc.NewLine()
+
+	t := table.NewTable("LEVEL", "RECORDS", "%").SetAlignments(table.AL, table.AR, table.AR)
+	t.FullScreen = false
+
+	for _, level := range []uint8{
+		LEVEL_FATAL, LEVEL_ERROR, LEVEL_WARN, LEVEL_INFO,
+		LEVEL_DEBUG, LEVEL_TRACE, LEVEL_UNKNOWN,
+	} {
+		name := levelCodes[level]
+		count := s.Levels[name]
+
+		if count == 0 {
+			continue
+		}
+
+		if name == "" {
+			name = "{s-}unknown{!}"
+		} else {
+			name = textColors[name] + name + "{!}"
+		}
+
+		t.Add(name, fmtutil.PrettyNum(count), fmtutil.PrettyPerc(float64(count)/float64(s.Total)*100))
+	}
+
+	t.Render()
+
+	s.printTop("CALLER", s.Callers)
+	s.printTop("MESSAGE", s.Messages)
+
+	fmtc.NewLine()
+}
+
+// printTop prints table with top entries from given map
+func (s *Stats) printTop(name string, data map[string]int) {
+	if len(data) == 0 {
+		return
+	}
+
+	fmtc.NewLine()
+
+	t := table.NewTable(name, "RECORDS").SetAlignments(table.AL, table.AR)
+	t.FullScreen = false
+
+	for _, e := range getTopEntries(data, STATS_TOP_SIZE) {
+		t.Add(escapeColorTags(strutil.Ellipsis(e.Name, 80)), fmtutil.PrettyNum(e.Count))
+	}
+
+	t.Render()
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// escapeColorTags escapes braces in given text so that table doesn't treat
+// them as color tags (zero-width space after brace makes tag invalid)
+func escapeColorTags(text string) string {
+	return strings.ReplaceAll(text, "{", "{\u200B")
+}
+
+// getTopEntries returns given number of entries with the biggest count
+func getTopEntries(data map[string]int, size int) []StatsEntry {
+	var result []StatsEntry
+
+	for name, count := range data {
+		result = append(result, StatsEntry{name, count})
+	}
+
+	slices.SortFunc(result, func(a, b StatsEntry) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Name, b.Name))
+	})
+
+	if len(result) > size {
+		result = result[:size]
+	}
+
+	return result
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
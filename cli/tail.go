@@ -0,0 +1,182 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/essentialkaos/ek/v13/options"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// MAX_TAIL_SIZE is maximum number of last records to show
+const MAX_TAIL_SIZE = 1_000_000
+
+// TAIL_CHUNK_SIZE is size of chunk used for reading file backward
+const TAIL_CHUNK_SIZE = 64 * 1024
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// configureTail checks tail options for given source files (empty list means
+// that data is read from stdin)
+func configureTail(files []string) error {
+	if !options.Has(OPT_LINES) || len(files) != 0 || !options.GetB(OPT_FOLLOW) {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"Option %s can't be used with %s for data from stdin",
+		options.F(OPT_LINES), options.F(OPT_FOLLOW),
+	)
+}
+
+// tailSources skips all data in sources except the last N records
+func tailSources(sources []*Source) error {
+	if !options.Has(OPT_LINES) {
+		return nil
+	}
+
+	size := options.GetI(OPT_LINES)
+
+	for _, s := range sources {
+		err := s.Tail(size)
+
+		if err != nil {
+			return fmt.Errorf("Can't read last records from %s: %w", s.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Tail skips all data in source except the last N records. Uncompressed files
+// are read backward, other sources are read till the end.
+func (s *Source) Tail(size int) error {
+	if s.isRegularFile() && !s.isCompressed() {
+		return s.seekTail(size)
+	}
+
+	s.bufferTail(size)
+
+	return nil
+}
+
+// seekTail reads file backward and sets read position to the beginning of
+// the Nth record from the end
+func (s *Source) seekTail(size int) error {
+	info, err := s.fd.Stat()
+
+	if err != nil {
+		return err
+	}
+
+	var count int
+	var carry []byte
+
+	pos, offset := info.Size(), int64(0)
+	chunk := make([]byte, TAIL_CHUNK_SIZE)
+
+SEARCH:
+	for pos > 0 {
+		chunkSize := min(pos, TAIL_CHUNK_SIZE)
+		pos -= chunkSize
+
+		_, err = s.fd.ReadAt(chunk[:chunkSize], pos)
+
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		data := append(chunk[:chunkSize:chunkSize], carry...)
+
+		for {
+			index := bytes.LastIndexByte(data, '\n')
+
+			if index == -1 {
+				break
+			}
+
+			if isRecordLine(data[index+1:]) {
+				count++
+
+				if count == size {
+					offset = pos + int64(index) + 1
+					break SEARCH
+				}
+			}
+
+			data = data[:index]
+		}
+
+		carry = bytes.Clone(data)
+	}
+
+	_, err = s.fd.Seek(offset, io.SeekStart)
+
+	if err != nil {
+		return err
+	}
+
+	s.reader = bufio.NewReader(s.fd)
+	s.scanner = bufio.NewScanner(s.reader)
+
+	return nil
+}
+
+// bufferTail reads all data from source and keeps the last N records
+func (s *Source) bufferTail(size int) {
+	var lines []string
+	var count int
+
+	for s.scanner.Scan() {
+		line := strings.TrimSpace(s.scanner.Text())
+
+		if line == "" {
+			continue
+		}
+
+		lines = append(lines, line)
+
+		if isRecordLine([]byte(line)) {
+			count++
+		}
+
+		// Remove the oldest record and all lines before the first record
+		for len(lines) > 0 && (count > size || (count == size && !isRecordLine([]byte(lines[0])))) {
+			if isRecordLine([]byte(lines[0])) {
+				count--
+			}
+
+			lines = lines[1:]
+		}
+	}
+
+	s.sample = lines
+}
+
+// isCompressed returns true if source data is compressed
+func (s *Source) isCompressed() bool {
+	_, isPlain := s.reader.(*bufio.Reader)
+	return !isPlain
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// isRecordLine returns true if given line contains JSON record
+func isRecordLine(line []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(line), []byte("{"))
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
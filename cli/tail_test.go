@@ -0,0 +1,78 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestSourceTail(t *testing.T) {
+	var data strings.Builder
+
+	// Data is bigger than one chunk to check reading across chunk boundaries
+	for i := range 3000 {
+		fmt.Fprintf(&data, `{"msg":"record %d","pad":"%s"}`+"\n", i, strings.Repeat("x", 40))
+	}
+
+	data.WriteString(`{"msg":"panic"}` + "\n")
+	data.WriteString("goroutine 1 [running]:\n\n")
+	data.WriteString(`{"msg":"last"}`)
+
+	file := filepath.Join(t.TempDir(), "test.log")
+
+	if err := os.WriteFile(file, []byte(data.String()), 0644); err != nil {
+		t.Fatalf("Can't create test file: %v", err)
+	}
+
+	tests := []struct {
+		size  int
+		count int    // Number of lines
+		first string // Prefix of the first line
+	}{
+		{1, 1, `{"msg":"last"}`},
+		{2, 3, `{"msg":"panic"}`},
+		{3, 4, `{"msg":"record 2999"`},
+		{3002, 3003, `{"msg":"record 0"`},
+		{5000, 3003, `{"msg":"record 0"`},
+	}
+
+	for _, tt := range tests {
+		sources, err := openSources([]string{file})
+
+		if err != nil {
+			t.Fatalf("Can't open test file: %v", err)
+		}
+
+		if err = sources[0].Tail(tt.size); err != nil {
+			t.Errorf("Tail(%d) returned error: %v", tt.size, err)
+		}
+
+		var lines []string
+
+		for sources[0].Next() {
+			lines = append(lines, sources[0].line)
+		}
+
+		closeSources(sources)
+
+		switch {
+		case len(lines) != tt.count:
+			t.Errorf("Tail(%d) returned %d lines, want %d", tt.size, len(lines), tt.count)
+		case !strings.HasPrefix(lines[0], tt.first):
+			t.Errorf("Tail(%d) first line is %q, want %q", tt.size, lines[0], tt.first)
+		case lines[len(lines)-1] != `{"msg":"last"}`:
+			t.Errorf("Tail(%d) last line is %q", tt.size, lines[len(lines)-1])
+		}
+	}
+}
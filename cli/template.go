@@ -0,0 +1,93 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"net"
+	"regexp"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Placeholders for variable parts of messages
+const (
+	PLACEHOLDER_STRING = "<str>"
+	PLACEHOLDER_UUID   = "<uuid>"
+	PLACEHOLDER_IP     = "<ip>"
+	PLACEHOLDER_HEX    = "<hex>"
+	PLACEHOLDER_NUMBER = "<num>"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// templateReplacer contains pattern of variable part of message and placeholder
+type templateReplacer struct {
+	Pattern     *regexp.Regexp
+	Placeholder string
+	Check       func(v string) bool // Optional check for found value
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// templateReplacers is a slice with replacers for variable parts of messages
+// (order is important)
+var templateReplacers = []templateReplacer{
+	{regexp.MustCompile(`"[^"]*"|'[^']*'`), PLACEHOLDER_STRING, nil},
+	{regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`), PLACEHOLDER_UUID, nil},
+	{regexp.MustCompile(`\b\d{1,3}(\.\d{1,3}){3}(:\d+)?\b`), PLACEHOLDER_IP, nil},
+	{regexp.MustCompile(`[\w:]*:[\w:]*`), PLACEHOLDER_IP, isIPv6},
+	{regexp.MustCompile(`(?i)\b0x[0-9a-f]+\b|\b[0-9a-f]{6,}\b`), PLACEHOLDER_HEX, isHex},
+	{regexp.MustCompile(`\d+(\.\d+)?`), PLACEHOLDER_NUMBER, nil},
+}
+
+// placeholderRegex is regular expression for searching placeholders in templates
+var placeholderRegex = regexp.MustCompile(`<(str|uuid|ip|hex|num)>`)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getMessageTemplate returns message template with variable parts (quoted
+// strings, UUIDs, IPs, hex values and numbers) replaced by placeholders
+func getMessageTemplate(msg string) string {
+	for _, r := range templateReplacers {
+		if r.Check == nil {
+			msg = r.Pattern.ReplaceAllLiteralString(msg, r.Placeholder)
+			continue
+		}
+
+		msg = r.Pattern.ReplaceAllStringFunc(msg, func(v string) string {
+			if r.Check(v) {
+				return r.Placeholder
+			}
+
+			return v
+		})
+	}
+
+	return msg
+}
+
+// isIPv6 returns true if given value is IPv6 address. Values without digits
+// (like "abc::def") are ignored because they are usually identifiers.
+func isIPv6(v string) bool {
+	return strings.Count(v, ":") >= 2 && strings.ContainsAny(v, "0123456789") &&
+		net.ParseIP(v) != nil
+}
+
+// isHex returns true if given value is hex value with letters and digits
+func isHex(v string) bool {
+	v = strings.ToLower(v)
+
+	if strings.HasPrefix(v, "0x") {
+		return true
+	}
+
+	return strings.ContainsAny(v, "0123456789") && strings.ContainsAny(v, "abcdef")
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
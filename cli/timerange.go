@@ -0,0 +1,158 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/essentialkaos/ek/v13/options"
+	"github.com/essentialkaos/ek/v13/timeutil"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// RANGE_OVER_THRESHOLD is number of consecutive records with timestamp after
+// the end of time range required to stop reading sorted data
+const RANGE_OVER_THRESHOLD = 16
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// TimeRange is time range used for records filtering
+type TimeRange struct {
+	Since time.Time
+	Until time.Time
+
+	overCount  int
+	lastTS     time.Time // Timestamp of the previous record
+	isUnsorted bool      // Data contains records with timestamps going backwards
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// timeRange is time range for filtering records
+var timeRange *TimeRange
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// configureTimeRange configures time range using options
+func configureTimeRange() error {
+	if !options.Has(OPT_SINCE) && !options.Has(OPT_UNTIL) {
+		return nil
+	}
+
+	var err error
+
+	timeRange = &TimeRange{}
+
+	if options.Has(OPT_SINCE) {
+		timeRange.Since, err = parseTimeBound(options.GetS(OPT_SINCE))
+
+		if err != nil {
+			return fmt.Errorf("Can't parse %s value: %w", options.F(OPT_SINCE), err)
+		}
+	}
+
+	if options.Has(OPT_UNTIL) {
+		timeRange.Until, err = parseTimeBound(options.GetS(OPT_UNTIL))
+
+		if err != nil {
+			return fmt.Errorf("Can't parse %s value: %w", options.F(OPT_UNTIL), err)
+		}
+	}
+
+	if !timeRange.Since.IsZero() && !timeRange.Until.IsZero() &&
+		timeRange.Until.Before(timeRange.Since) {
+		return fmt.Errorf("Invalid time range: end of range is before its start")
+	}
+
+	return nil
+}
+
+// parseTimeBound parses time range bound (absolute date or relative duration)
+func parseTimeBound(v string) (time.Time, error) {
+	now := time.Now()
+	v = strings.TrimSpace(v)
+
+	switch strings.ToLower(v) {
+	case "":
+		return time.Time{}, fmt.Errorf("Value is empty")
+	case "now":
+		return now, nil
+	case "today":
+		return timeutil.StartOfDay(now), nil
+	case "yesterday":
+		return timeutil.StartOfDay(timeutil.PrevDay(now)), nil
+	case "tomorrow":
+		return timeutil.StartOfDay(timeutil.NextDay(now)), nil
+	}
+
+	t := parseTimeWithLayouts(v)
+
+	if !t.IsZero() {
+		return t, nil
+	}
+
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		t, err := time.ParseInLocation(layout, v, time.Local)
+
+		if err == nil {
+			return timeutil.StartOfDay(now).Add(
+				time.Duration(t.Hour())*time.Hour +
+					time.Duration(t.Minute())*time.Minute +
+					time.Duration(t.Second())*time.Second,
+			), nil
+		}
+	}
+
+	dur := strings.TrimPrefix(strings.TrimSuffix(v, " ago"), "-")
+	d, err := timeutil.ParseDuration(dur)
+
+	if err != nil || d == 0 {
+		return time.Time{}, fmt.Errorf("Unsupported date or duration %q", v)
+	}
+
+	return now.Add(-d), nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IsMatch returns true if given timestamp is within time range
+func (r *TimeRange) IsMatch(t time.Time) bool {
+	if r == nil {
+		return true
+	}
+
+	if t.IsZero() {
+		return false
+	}
+
+	if t.Before(r.lastTS) {
+		r.isUnsorted = true
+	}
+
+	r.lastTS = t
+
+	if !r.Until.IsZero() && t.After(r.Until) {
+		r.overCount++
+		return false
+	}
+
+	r.overCount = 0
+
+	return r.Since.IsZero() || !t.Before(r.Since)
+}
+
+// IsOver returns true if sorted data is read past the end of time range. Once
+// records with timestamps going backwards are found, data is read till the end.
+func (r *TimeRange) IsOver() bool {
+	return r != nil && !r.isUnsorted && r.overCount >= RANGE_OVER_THRESHOLD
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
@@ -0,0 +1,374 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/essentialkaos/ek/v13/knf"
+	"github.com/essentialkaos/ek/v13/options"
+	"github.com/essentialkaos/ek/v13/timeutil"
+
+	"github.com/tidwall/gjson"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Special layouts for epoch timestamps
+const (
+	LAYOUT_UNIX    = "unix"
+	LAYOUT_UNIX_MS = "unix-ms"
+	LAYOUT_UNIX_US = "unix-us"
+	LAYOUT_UNIX_NS = "unix-ns"
+)
+
+// Timestamp display formats
+const (
+	TIME_FORMAT_DEFAULT  = "%y/%m/%d %H:%M:%S.%K"
+	TIME_FORMAT_RELATIVE = "relative"
+	TIME_FORMAT_DELTA    = "delta"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// timeLayouts is a slice with supported timestamp layouts
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05Z0700",
+	"2006-01-02 15:04:05 -0700 MST",
+	"2006-01-02 15:04:05 -0700",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.RFC822Z,
+	time.RFC822,
+	time.RubyDate,
+	time.UnixDate,
+	time.ANSIC,
+	"02/Jan/2006:15:04:05 -0700",
+}
+
+// localTimeLayouts is a slice with supported timestamp layouts without timezone
+var localTimeLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006/01/02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"Jan _2 15:04:05",
+}
+
+// timeLayout is custom timestamp layout
+var timeLayout string
+
+// timeFormat is timestamp display format
+var timeFormat = TIME_FORMAT_DEFAULT
+
+// timeZone is timezone used for displaying timestamps
+var timeZone = time.Local
+
+// prevTS is timestamp of previous rendered record
+var prevTS time.Time
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// configureTime configures timestamps decoding
+func configureTime(config *knf.Config) error {
+	timeLayout = config.GetS(TIME_LAYOUT)
+
+	if options.Has(OPT_TIME_LAYOUT) {
+		timeLayout = options.GetS(OPT_TIME_LAYOUT)
+	}
+
+	timeFormat = config.GetS(TIME_FORMAT, timeFormat)
+
+	if options.Has(OPT_TIME_FORMAT) {
+		timeFormat = options.GetS(OPT_TIME_FORMAT)
+	}
+
+	tz := config.GetS(TIME_TIMEZONE)
+
+	if options.Has(OPT_TIMEZONE) {
+		tz = options.GetS(OPT_TIMEZONE)
+	}
+
+	switch strings.ToLower(tz) {
+	case "", "local":
+		timeZone = time.Local
+	case "utc":
+		timeZone = time.UTC
+	default:
+		loc, err := time.LoadLocation(tz)
+
+		if err != nil {
+			return fmt.Errorf("Can't load timezone %q: %w", tz, err)
+		}
+
+		timeZone = loc
+	}
+
+	return nil
+}
+
+// formatTimestamp formats timestamp for displaying and returns formatted
+// timestamp with color tags and its visual size
+func formatTimestamp(t time.Time) (string, int) {
+	var ts string
+
+	switch timeFormat {
+	case TIME_FORMAT_RELATIVE:
+		ts = formatRelativeTime(t)
+	case TIME_FORMAT_DELTA:
+		ts = formatDeltaTime(t)
+	default:
+		if t.IsZero() {
+			ts = strings.Map(maskDigit, timeutil.Format(time.Unix(0, 0), timeFormat))
+			break
+		}
+
+		ts = timeutil.Format(t.In(timeZone), timeFormat)
+
+		if strings.HasSuffix(timeFormat, ".%K") {
+			return "{s}" + ts[:len(ts)-4] + "{s-}" + ts[len(ts)-4:], utf8.RuneCountInString(ts)
+		}
+	}
+
+	return "{s}" + ts, utf8.RuneCountInString(ts)
+}
+
+// formatRelativeTime formats time relative to the current moment
+func formatRelativeTime(t time.Time) string {
+	if t.IsZero() {
+		return "--"
+	}
+
+	d := time.Since(t)
+
+	switch {
+	case d < 0:
+		return "in " + timeutil.MiniDuration(-d, "")
+	case d < time.Second:
+		return "now"
+	}
+
+	return timeutil.MiniDuration(d.Truncate(time.Second), "") + " ago"
+}
+
+// formatDeltaTime formats time difference with previous record
+func formatDeltaTime(t time.Time) string {
+	if t.IsZero() {
+		return "--"
+	}
+
+	if prevTS.IsZero() {
+		prevTS = t
+		return "+0s"
+	}
+
+	d := t.Sub(prevTS)
+	prevTS = t
+
+	switch {
+	case d < 0:
+		return "-" + timeutil.MiniDuration(-d, "")
+	case d == 0:
+		return "+0s"
+	}
+
+	return "+" + timeutil.MiniDuration(d, "")
+}
+
+// parseTimestamp decodes timestamp from given field
+func parseTimestamp(v gjson.Result) time.Time {
+	switch v.Type {
+	case gjson.Number:
+		return parseEpochTimestamp(v.Raw)
+	case gjson.String:
+		return parseStringTimestamp(strings.TrimSpace(v.Str))
+	}
+
+	return time.Time{}
+}
+
+// parseStringTimestamp decodes timestamp from string
+func parseStringTimestamp(v string) time.Time {
+	if v == "" {
+		return time.Time{}
+	}
+
+	if timeLayout != "" {
+		switch timeLayout {
+		case LAYOUT_UNIX, LAYOUT_UNIX_MS, LAYOUT_UNIX_US, LAYOUT_UNIX_NS:
+			return parseEpochTimestamp(v)
+		}
+
+		t, err := time.ParseInLocation(timeLayout, v, time.Local)
+
+		if err == nil {
+			return t
+		}
+	}
+
+	if isNumericTimestamp(v) {
+		return parseEpochTimestamp(v)
+	}
+
+	return parseTimeWithLayouts(v)
+}
+
+// parseTimeWithLayouts parses time using all supported layouts
+func parseTimeWithLayouts(v string) time.Time {
+	for _, layout := range timeLayouts {
+		t, err := time.Parse(layout, v)
+
+		if err == nil {
+			return t
+		}
+	}
+
+	for _, layout := range localTimeLayouts {
+		t, err := time.ParseInLocation(layout, v, time.Local)
+
+		if err == nil {
+			if t.Year() == 0 {
+				t = t.AddDate(time.Now().Year(), 0, 0)
+			}
+
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// parseEpochTimestamp decodes epoch timestamp in seconds, milliseconds,
+// microseconds or nanoseconds
+func parseEpochTimestamp(v string) time.Time {
+	if strings.ContainsAny(v, "eE") {
+		f, err := strconv.ParseFloat(v, 64)
+
+		if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return time.Time{}
+		}
+
+		v = strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	intPart, fracPart, _ := strings.Cut(v, ".")
+	i, err := strconv.ParseInt(intPart, 10, 64)
+
+	if err != nil {
+		return time.Time{}
+	}
+
+	frac, ok := parseEpochFraction(fracPart)
+
+	if !ok {
+		return time.Time{}
+	}
+
+	if strings.HasPrefix(intPart, "-") {
+		frac = -frac
+	}
+
+	return epochToTime(i, frac)
+}
+
+// parseEpochFraction parses digits of fractional part of epoch timestamp
+// as number of billionths of time unit
+func parseEpochFraction(v string) (int64, bool) {
+	if strings.Trim(v, "0123456789") != "" {
+		return 0, false
+	}
+
+	if len(v) > 9 {
+		v = v[:9]
+	} else {
+		v += strings.Repeat("0", 9-len(v))
+	}
+
+	frac, err := strconv.ParseInt(v, 10, 64)
+
+	return frac, err == nil
+}
+
+// epochToTime converts epoch timestamp with given integer part and fractional
+// part (in billionths of time unit) to time
+func epochToTime(i, frac int64) time.Time {
+	var unit time.Duration
+
+	switch timeLayout {
+	case LAYOUT_UNIX:
+		unit = time.Second
+	case LAYOUT_UNIX_MS:
+		unit = time.Millisecond
+	case LAYOUT_UNIX_US:
+		unit = time.Microsecond
+	case LAYOUT_UNIX_NS:
+		unit = time.Nanosecond
+	default:
+		unit = guessEpochUnit(i)
+	}
+
+	sec := i / int64(time.Second/unit)
+	nsec := (i%int64(time.Second/unit))*int64(unit) + frac*int64(unit)/int64(time.Second)
+
+	return time.Unix(sec, nsec)
+}
+
+// guessEpochUnit guesses epoch timestamp unit using its magnitude
+func guessEpochUnit(i int64) time.Duration {
+	if i < 0 {
+		i = -i
+	}
+
+	switch {
+	case i < 100_000_000_000:
+		return time.Second
+	case i < 100_000_000_000_000:
+		return time.Millisecond
+	case i < 100_000_000_000_000_000:
+		return time.Microsecond
+	}
+
+	return time.Nanosecond
+}
+
+// isNumericTimestamp returns true if given string contains epoch timestamp
+func isNumericTimestamp(v string) bool {
+	for i, c := range v {
+		switch {
+		case c >= '0' && c <= '9', c == '.':
+			continue
+		case c == '-' && i == 0:
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// maskDigit replaces digits with dashes
+func maskDigit(r rune) rune {
+	if r >= '0' && r <= '9' {
+		return '-'
+	}
+
+	return r
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
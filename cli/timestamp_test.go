@@ -0,0 +1,75 @@
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"testing"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func TestParseEpochTimestamp(t *testing.T) {
+	tests := []struct {
+		layout string
+		value  string
+		want   int64 // Nanoseconds since epoch
+	}{
+		{"", "1700000000", 1700000000_000000000},
+		{"", "1700000000.123", 1700000000_123000000},
+		{"", "1700000000.999999999", 1700000000_999999999},
+		{"", "1700000000.1234567891", 1700000000_123456789},
+		{"", "1700000000123", 1700000000_123000000},
+		{"", "1700000000123.456", 1700000000_123456000},
+		{"", "1700000000123456", 1700000000_123456000},
+		{"", "1700000000123456789", 1700000000_123456789},
+		{"", "1.7e9", 1700000000_000000000},
+		{"", "-1.5", -1_500000000},
+		{LAYOUT_UNIX_MS, "1500.5", 1_500500000},
+		{LAYOUT_UNIX, "1700000000", 1700000000_000000000},
+		{LAYOUT_UNIX_US, "1.5", 1500},
+		{LAYOUT_UNIX_NS, "100", 100},
+	}
+
+	defer func() { timeLayout = "" }()
+
+	for _, tt := range tests {
+		timeLayout = tt.layout
+		ts := parseEpochTimestamp(tt.value)
+
+		if ts.IsZero() || ts.UnixNano() != tt.want {
+			t.Errorf(
+				"parseEpochTimestamp(%q) with layout %q = %d, want %d",
+				tt.value, tt.layout, ts.UnixNano(), tt.want,
+			)
+		}
+	}
+
+	timeLayout = ""
+
+	for _, v := range []string{"", "abc", "1.2.3", "1.5x", "1e999", "NaN"} {
+		if !parseEpochTimestamp(v).IsZero() {
+			t.Errorf("parseEpochTimestamp(%q) must return zero time", v)
+		}
+	}
+}
+
+func TestParseStringTimestamp(t *testing.T) {
+	want := time.Date(2024, 3, 1, 10, 20, 30, 123000000, time.UTC)
+
+	for _, v := range []string{
+		"2024-03-01T10:20:30.123Z",
+		"2024-03-01T13:20:30.123+03:00",
+		"1709288430.123",
+		"1709288430123",
+	} {
+		if got := parseStringTimestamp(v); !got.Equal(want) {
+			t.Errorf("parseStringTimestamp(%q) = %v, want %v", v, got, want)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+//go:build linux
+// +build linux
+
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"path/filepath"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// WATCH_TIMEOUT is maximum time of waiting for inotify events
+const WATCH_TIMEOUT = time.Second
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// FileWatcher waits for file changes using inotify
+type FileWatcher struct {
+	fd int
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// newFileWatcher creates watcher for file with given path. It returns nil if
+// inotify is not available.
+func newFileWatcher(path string) *FileWatcher {
+	if path == "" {
+		return nil
+	}
+
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+
+	if err != nil {
+		return nil
+	}
+
+	w := &FileWatcher{fd}
+
+	// Watch directory for catching creation of new file after rotation
+	_, err = unix.InotifyAddWatch(fd, filepath.Dir(path), unix.IN_CREATE|unix.IN_MOVED_TO)
+
+	if err != nil || !w.Add(path) {
+		unix.Close(fd)
+		return nil
+	}
+
+	return w
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Add adds watch for file with given path
+func (w *FileWatcher) Add(path string) bool {
+	if w == nil {
+		return false
+	}
+
+	_, err := unix.InotifyAddWatch(
+		w.fd, path,
+		unix.IN_MODIFY|unix.IN_ATTRIB|unix.IN_MOVE_SELF|unix.IN_DELETE_SELF,
+	)
+
+	return err == nil
+}
+
+// Wait waits for file changes (falls back to polling if inotify is not available)
+func (w *FileWatcher) Wait() {
+	if w == nil {
+		time.Sleep(POLL_INTERVAL)
+		return
+	}
+
+	fds := []unix.PollFd{{Fd: int32(w.fd), Events: unix.POLLIN}}
+	n, err := unix.Poll(fds, int(WATCH_TIMEOUT.Milliseconds()))
+
+	if err != nil && err != unix.EINTR {
+		time.Sleep(POLL_INTERVAL)
+		return
+	}
+
+	if n > 0 {
+		w.drain()
+	}
+}
+
+// Close closes watcher
+func (w *FileWatcher) Close() {
+	if w != nil {
+		unix.Close(w.fd)
+	}
+}
+
+// drain reads all pending events
+func (w *FileWatcher) drain() {
+	buf := make([]byte, 4096)
+
+	for {
+		n, err := unix.Read(w.fd, buf)
+
+		if err != nil || n <= 0 {
+			return
+		}
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
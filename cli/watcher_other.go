@@ -0,0 +1,44 @@
+//go:build !linux
+// +build !linux
+
+package cli
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                         Copyright (c) 2025 ESSENTIAL KAOS                          //
+//      Apache License, Version 2.0 <https://www.apache.org/licenses/LICENSE-2.0>     //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// FileWatcher waits for file changes using polling
+type FileWatcher struct{}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// newFileWatcher creates watcher for file with given path
+func newFileWatcher(path string) *FileWatcher {
+	return nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Add adds watch for file with given path
+func (w *FileWatcher) Add(path string) bool {
+	return false
+}
+
+// Wait waits for file changes
+func (w *FileWatcher) Wait() {
+	time.Sleep(POLL_INTERVAL)
+}
+
+// Close closes watcher
+func (w *FileWatcher) Close() {}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
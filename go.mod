@@ -4,12 +4,14 @@ go 1.23.8
 
 require (
 	github.com/essentialkaos/ek/v13 v13.30.1
+	github.com/klauspost/compress v1.18.0
 	github.com/tidwall/gjson v1.18.0
+	github.com/ulikunitz/xz v0.5.15
+	golang.org/x/sys v0.33.0
 )
 
 require (
 	github.com/essentialkaos/depsy v1.3.1 // indirect
 	github.com/tidwall/match v1.1.1 // indirect
 	github.com/tidwall/pretty v1.2.1 // indirect
-	golang.org/x/sys v0.33.0 // indirect
 )